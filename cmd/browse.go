@@ -0,0 +1,221 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+	"time"
+
+	"github.com/phantompunk/kata/internal/app"
+	"github.com/phantompunk/kata/internal/domain"
+	"github.com/phantompunk/kata/internal/leetcode"
+	"github.com/phantompunk/kata/internal/render"
+	"github.com/phantompunk/kata/internal/repository"
+	"github.com/phantompunk/kata/internal/table"
+	"github.com/phantompunk/kata/internal/ui"
+	"github.com/phantompunk/kata/pkg/editor"
+	"github.com/spf13/cobra"
+)
+
+func newBrowseCmd(kata *app.App) *cobra.Command {
+	var language string
+	var timeout time.Duration
+
+	cmd := &cobra.Command{
+		Use:   "browse",
+		Short: "Browse, edit, test and submit problems in an interactive workspace",
+		Long: `Browse downloaded problems with their progress and a preview of the statement.
+
+Keys: / filter, e open in $EDITOR, t test, s submit, r refresh from LeetCode,
+pgup/pgdn scroll the preview, q quit.`,
+		PreRunE: browsePreRun(kata, &language),
+		RunE:    handleErrors(kata, browseFunc(kata, &language, &timeout)),
+		Args:    cobra.NoArgs,
+	}
+
+	cmd.Flags().StringVarP(&language, "language", "l", "", "Programming language to use")
+	cmd.Flags().DurationVar(&timeout, "timeout", 0, "How long to wait for a verdict (default from config)")
+
+	return cmd
+}
+
+func browseFunc(kata *app.App, language *string, timeout *time.Duration) CommandFunc {
+	return func(cmd *cobra.Command, args []string) error {
+		workspace := &browseWorkspace{
+			kata: kata,
+			opts: app.AppOptions{
+				Language:  *language,
+				Tracks:    kata.Config.Tracks,
+				Workspace: kata.Config.WorkspacePath(),
+				IsPremium: kata.Config.IsPremium,
+			},
+			timeout: resultTimeout(kata, *timeout),
+		}
+
+		questions, err := workspace.List(cmd.Context())
+		if err != nil {
+			return fmt.Errorf("listing questions: %w", err)
+		}
+
+		config := table.DefaultConfig()
+		config.MaxHeight = kata.Config.ListHeight
+		return table.Browse(cmd.Context(), workspace, questions, kata.Config.Tracks, config)
+	}
+}
+
+func browsePreRun(kata *app.App, language *string) func(cmd *cobra.Command, args []string) error {
+	validateLanguage := validateLanguagePreRun(kata, language)
+	return func(cmd *cobra.Command, args []string) error {
+		if ui.PresenterFrom(cmd.Context()).JSONOutput() {
+			return errors.New("browse is interactive and has no json output, use kata list --output json")
+		}
+		return validateLanguage(cmd, args)
+	}
+}
+
+// browseWorkspace runs the browser's actions through the question service,
+// verdicts are written by the same presenter the test and submit commands use
+type browseWorkspace struct {
+	kata    *app.App
+	opts    app.AppOptions
+	timeout time.Duration
+}
+
+func (w *browseWorkspace) List(ctx context.Context) ([]domain.QuestionStat, error) {
+	return w.kata.Question.GetAllQuestionsWithStatus(ctx, w.opts, repository.ListFilter{})
+}
+
+func (w *browseWorkspace) Statement(ctx context.Context, slug string) (string, error) {
+	problem, err := w.problem(ctx, slug)
+	if err != nil {
+		return "", err
+	}
+
+	markdown, err := render.Markdown(problem.Content)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("# %s. %s\n\n%s\n\n%s", problem.ID, problem.Title, problem.Difficulty, markdown), nil
+}
+
+// Edit opens the solution, stubbing the problem first if it was never started
+func (w *browseWorkspace) Edit(ctx context.Context, slug string) (*exec.Cmd, error) {
+	problem, err := w.problem(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+
+	if !problem.SolutionExists() {
+		if _, err := w.kata.Question.Stub(ctx, problem, w.opts); err != nil {
+			return nil, fmt.Errorf("stubbing %s: %w", slug, err)
+		}
+	}
+	return editor.Command(problem.SolutionPath()), nil
+}
+
+func (w *browseWorkspace) Test(ctx context.Context, slug string) (table.Verdict, error) {
+	problem, err := w.problem(ctx, slug)
+	if err != nil || !problem.SolutionExists() {
+		return noSolution(problem), err
+	}
+
+	submissionId, err := w.kata.Question.SubmitTest(ctx, problem, w.opts)
+	if err != nil {
+		return table.Verdict{}, err
+	}
+
+	result, err := w.kata.Question.WaitForResult(ctx, problem, submissionId, w.timeout)
+	if err != nil {
+		return pendingVerdict(submissionId, err)
+	}
+
+	var details bytes.Buffer
+	ui.NewPresenterWithWriter(&details).ShowTestResults(result, problem)
+
+	summary := "✔ All test cases passed"
+	if !result.IsAccepted() {
+		summary = fmt.Sprintf("✘ %s", result.Status)
+	}
+	return table.Verdict{Summary: summary, Details: details.String()}, nil
+}
+
+// Submit sends the solution, the browser has no room for the rating prompt so
+// accepted solutions leave the review schedule unchanged
+func (w *browseWorkspace) Submit(ctx context.Context, slug string) (table.Verdict, error) {
+	problem, err := w.problem(ctx, slug)
+	if err != nil || !problem.SolutionExists() {
+		return noSolution(problem), err
+	}
+
+	submissionId, err := w.kata.Question.SubmitSolution(ctx, problem, w.opts)
+	if err != nil {
+		return table.Verdict{}, err
+	}
+
+	result, err := w.kata.Question.WaitForResult(ctx, problem, submissionId, w.timeout)
+	if err != nil {
+		return pendingVerdict(submissionId, err)
+	}
+
+	var details bytes.Buffer
+	ui.NewPresenterWithWriter(&details).ShowSubmissionResults(result)
+
+	summary := verdictSummary(result)
+	if result.IsAccepted() {
+		elapsed, ok, err := w.kata.Question.StopTimer(ctx, problem)
+		if err != nil {
+			return table.Verdict{}, err
+		}
+		if ok {
+			summary += fmt.Sprintf(", solved in %s", elapsed.Round(time.Second))
+		}
+	}
+	return table.Verdict{Summary: summary, Details: details.String()}, nil
+}
+
+// Refresh downloads the problem again so its statement and snippets are current
+func (w *browseWorkspace) Refresh(ctx context.Context, slug string) error {
+	opts := w.opts
+	opts.Problem = slug
+
+	_, err := w.kata.Question.RefreshQuestion(ctx, opts)
+	return err
+}
+
+func (w *browseWorkspace) problem(ctx context.Context, slug string) (*domain.Problem, error) {
+	opts := w.opts
+	opts.Problem = slug
+	return w.kata.Question.GetBySlug(ctx, opts)
+}
+
+func noSolution(problem *domain.Problem) table.Verdict {
+	if problem == nil {
+		return table.Verdict{}
+	}
+	return table.Verdict{
+		Summary: fmt.Sprintf("✘ No %s solution for %s yet, press e to start one", problem.Language.DisplayName(), problem.Title),
+	}
+}
+
+// pendingVerdict turns a verdict that is still being judged into a hint
+// instead of an error, other errors are passed on
+func pendingVerdict(submissionId string, err error) (table.Verdict, error) {
+	if errors.Is(err, app.ErrResultTimeout) {
+		return table.Verdict{
+			Summary: fmt.Sprintf("ℹ Still judging, check later with: kata result %s", submissionId),
+		}, nil
+	}
+	return table.Verdict{}, err
+}
+
+func verdictSummary(result *leetcode.SubmissionResult) string {
+	if result.IsAccepted() {
+		return fmt.Sprintf("✔ Accepted in %s", result.Runtime)
+	}
+	if result.TotalTestcases > 0 {
+		return fmt.Sprintf("✘ %s, passed %d/%d test cases", result.Status, result.TotalCorrect, result.TotalTestcases)
+	}
+	return fmt.Sprintf("✘ %s", result.Status)
+}
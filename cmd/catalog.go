@@ -0,0 +1,73 @@
+package cmd
+
+import (
+	"fmt"
+	"math/rand/v2"
+
+	"github.com/phantompunk/kata/internal/app"
+	"github.com/phantompunk/kata/internal/repository"
+	"github.com/phantompunk/kata/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+func newCatalogCmd(kata *app.App) *cobra.Command {
+	var filter repository.CatalogFilter
+	var get bool
+	var language string
+
+	cmd := &cobra.Command{
+		Use:     "catalog",
+		Short:   "Browse and filter every Leetcode problem in the synced catalog",
+		PreRunE: validateLanguagePreRun(kata, &language),
+		RunE:    handleErrors(kata, catalogFunc(kata, &filter, &get, &language)),
+		Args:    cobra.NoArgs,
+	}
+
+	cmd.Flags().StringVarP(&filter.Difficulty, "difficulty", "d", "", "Filter by difficulty (easy, medium, hard)")
+	cmd.Flags().StringVarP(&filter.Tag, "tag", "t", "", "Filter by topic tag, e.g. dynamic-programming")
+	cmd.Flags().BoolVar(&filter.PaidOnly, "paid-only", false, "Show only premium problems")
+	cmd.Flags().BoolVar(&filter.FreeOnly, "free", false, "Show only free problems")
+	cmd.Flags().BoolVar(&filter.NotDownloaded, "not-downloaded", false, "Show only problems not yet downloaded")
+	cmd.Flags().Float64Var(&filter.MinAcRate, "min-acceptance", 0, "Minimum acceptance rate percentage")
+	cmd.Flags().Float64Var(&filter.MaxAcRate, "max-acceptance", 0, "Maximum acceptance rate percentage")
+	cmd.Flags().IntVarP(&filter.Limit, "limit", "n", 50, "Maximum number of problems to show, 0 for all")
+	cmd.Flags().BoolVarP(&get, "get", "g", false, "Download and stub a random problem from the results")
+	cmd.Flags().StringVarP(&language, "language", "l", "", "Programming language to use with --get")
+	cmd.MarkFlagsMutuallyExclusive("paid-only", "free")
+
+	return cmd
+}
+
+func catalogFunc(kata *app.App, filter *repository.CatalogFilter, get *bool, language *string) CommandFunc {
+	return func(cmd *cobra.Command, args []string) error {
+		presenter := ui.PresenterFrom(cmd.Context())
+
+		query := *filter
+		if *get {
+			// pick from every match rather than the first page
+			query.Limit = 0
+		}
+
+		entries, err := kata.Catalog.List(cmd.Context(), query)
+		if err != nil {
+			return fmt.Errorf("listing catalog: %w", err)
+		}
+
+		if !*get || len(entries) == 0 {
+			presenter.ShowCatalog(entries)
+			return nil
+		}
+
+		entry := entries[rand.IntN(len(entries))]
+		presenter.ShowCatalogPick(entry, len(entries))
+
+		opts := app.AppOptions{
+			Problem:   entry.Slug,
+			Language:  *language,
+			Workspace: kata.Config.WorkspacePath(),
+			IsPremium: kata.Config.IsPremium,
+		}
+
+		return stubProblem(cmd, kata, presenter, opts)
+	}
+}
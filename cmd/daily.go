@@ -0,0 +1,49 @@
+package cmd
+
+import (
+	"github.com/phantompunk/kata/internal/app"
+	"github.com/phantompunk/kata/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+func newDailyCmd(kata *app.App) *cobra.Command {
+	var open, force bool
+	var language string
+
+	cmd := &cobra.Command{
+		Use:     "daily",
+		Short:   "Download and stub today's Leetcode daily challenge",
+		PreRunE: validateLanguagePreRun(kata, &language),
+		RunE:    handleErrors(kata, dailyFunc(kata, &open, &force, &language)),
+		Args:    cobra.NoArgs,
+	}
+
+	cmd.Flags().BoolVarP(&open, "open", "o", false, "Open problem with $EDITOR")
+	cmd.Flags().BoolVarP(&force, "force", "f", false, "Force download even if problem already exists")
+	cmd.Flags().StringVarP(&language, "language", "l", "", "Programming language to use")
+
+	return cmd
+}
+
+func dailyFunc(kata *app.App, open, force *bool, language *string) CommandFunc {
+	return func(cmd *cobra.Command, args []string) error {
+		presenter := ui.PresenterFrom(cmd.Context())
+
+		daily, err := kata.Question.GetDailyChallenge(cmd.Context())
+		if err != nil {
+			return err
+		}
+		presenter.ShowDailyChallenge(daily.Date, daily.Question.Title, daily.Question.Difficulty)
+
+		opts := app.AppOptions{
+			Problem:   daily.Question.TitleSlug,
+			Language:  *language,
+			Workspace: kata.Config.WorkspacePath(),
+			Open:      *open,
+			Force:     *force,
+			IsPremium: kata.Config.IsPremium,
+		}
+
+		return stubProblem(cmd, kata, presenter, opts)
+	}
+}
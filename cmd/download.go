@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/phantompunk/kata/internal/app"
+	"github.com/phantompunk/kata/internal/domain"
 	"github.com/phantompunk/kata/internal/ui"
 	"github.com/spf13/cobra"
 )
@@ -31,8 +32,11 @@ func newDownloadCmd(kata *app.App) *cobra.Command {
 
 func downloadFunc(kata *app.App, open, force, retry *bool, language *string) CommandFunc {
 	return func(cmd *cobra.Command, args []string) error {
-		problemName := app.ConvertToSlug(args[0])
-		presenter := ui.NewPresenter()
+		presenter := ui.PresenterFrom(cmd.Context())
+		problemName, err := kata.Catalog.ResolveSlug(cmd.Context(), args[0])
+		if err != nil {
+			return err
+		}
 
 		opts := app.AppOptions{
 			Problem:   problemName,
@@ -44,41 +48,55 @@ func downloadFunc(kata *app.App, open, force, retry *bool, language *string) Com
 			IsPremium: kata.Config.IsPremium,
 		}
 
-		problem, err := kata.Question.GetQuestion(cmd.Context(), opts)
-		if err != nil {
-			if errors.Is(err, app.ErrQuestionNotFound) {
-				presenter.ShowProblemNotFound(problemName)
-				return nil
-			}
-
-			if errors.Is(err, app.ErrPaidOnlyProblem) {
-				presenter.ShowPaywalledProblem(problem.Title, problem.Slug)
-				return nil
-			}
-
-			// TODO Is this needed? Should rely on underlying error
-			return fmt.Errorf("fetching question %q: %w", opts.Problem, err)
-		}
+		return stubProblem(cmd, kata, presenter, opts)
+	}
+}
 
-		presenter.ShowProblemFetched(problem.Title)
+// stubProblem fetches a problem and renders its files into the workspace
+func stubProblem(cmd *cobra.Command, kata *app.App, presenter *ui.Presenter, opts app.AppOptions) error {
+	problem, err := fetchProblem(cmd, kata, presenter, opts)
+	if err != nil || problem == nil {
+		return err
+	}
 
-		if problem.DirectoryPath.Exists() && !*force && !*retry {
-			presenter.ShowProblemAlreadyExists(problem.Title, problem.DirectoryPath.DisplayPath(), problem.Slug)
-			return nil
-		}
+	presenter.ShowProblemFetched(problem.Title)
+
+	if problem.DirectoryPath.Exists() && !opts.Force && !opts.Retry {
+		presenter.ShowProblemAlreadyExists(problem.Title, problem.DirectoryPath.DisplayPath(), problem.Slug)
+		return nil
+	}
 
-		if *retry && !problem.DirectoryPath.Exists() {
-			presenter.ShowProblemDoesNotExist(problem.Title, problem.DirectoryPath.DisplayPath(), problem.Slug)
-			return nil
+	if opts.Retry && !problem.DirectoryPath.Exists() {
+		presenter.ShowProblemDoesNotExist(problem.Title, problem.DirectoryPath.DisplayPath(), problem.Slug)
+		return nil
+	}
+
+	result, err := kata.Question.Stub(cmd.Context(), problem, opts)
+	if err != nil {
+		return fmt.Errorf("stubbing question %q: %w", opts.Problem, err)
+	}
+	presenter.ShowRenderResults(result, problem.Slug, opts.Force)
+
+	return nil
+}
+
+// fetchProblem gets a problem from the database or LeetCode. Missing and
+// premium problems are explained to the user and return a nil problem.
+func fetchProblem(cmd *cobra.Command, kata *app.App, presenter *ui.Presenter, opts app.AppOptions) (*domain.Problem, error) {
+	problem, err := kata.Question.GetQuestion(cmd.Context(), opts)
+	if err != nil {
+		if errors.Is(err, app.ErrQuestionNotFound) {
+			presenter.ShowProblemNotFound(opts.Problem)
+			return nil, nil
 		}
 
-		result, err := kata.Question.Stub(cmd.Context(), problem, opts)
-		if err != nil {
-			return fmt.Errorf("stubbing question %q: %w", opts.Problem, err)
+		if errors.Is(err, app.ErrPaidOnlyProblem) {
+			presenter.ShowPaywalledProblem(problem.Title, problem.Slug)
+			return nil, nil
 		}
-		presenter.ShowRenderResults(result, problem.Slug, opts.Force)
 
-		return nil
+		// TODO Is this needed? Should rely on underlying error
+		return nil, fmt.Errorf("fetching question %q: %w", opts.Problem, err)
 	}
+	return problem, nil
 }
-
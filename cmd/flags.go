@@ -0,0 +1,74 @@
+package cmd
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/phantompunk/kata/internal/ui"
+)
+
+// ageValue is a duration flag that also accepts days and weeks, e.g. 14d or 2w
+type ageValue time.Duration
+
+func (a *ageValue) Set(s string) error {
+	units := map[string]time.Duration{"d": 24 * time.Hour, "w": 7 * 24 * time.Hour}
+	for suffix, unit := range units {
+		if count, ok := strings.CutSuffix(s, suffix); ok {
+			n, err := strconv.Atoi(count)
+			if err != nil || n < 0 {
+				return fmt.Errorf("invalid age %q", s)
+			}
+			*a = ageValue(time.Duration(n) * unit)
+			return nil
+		}
+	}
+
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return fmt.Errorf("invalid age %q, use a number of days like 14d", s)
+	}
+	*a = ageValue(d)
+	return nil
+}
+
+func (a *ageValue) String() string {
+	d := time.Duration(*a)
+	if d == 0 {
+		return ""
+	}
+	if d%(24*time.Hour) == 0 {
+		return fmt.Sprintf("%dd", d/(24*time.Hour))
+	}
+	return d.String()
+}
+
+func (a *ageValue) Type() string {
+	return "age"
+}
+
+// outputValue is the global output mode, text or json. Setting it switches
+// the presenter right away, so argument errors are reported in that mode too.
+type outputValue struct {
+	mode      string
+	presenter *ui.Presenter
+}
+
+func (o *outputValue) Set(s string) error {
+	if !slices.Contains(ui.Outputs, s) {
+		return fmt.Errorf("invalid output %q, use one of %s", s, strings.Join(ui.Outputs, ", "))
+	}
+	o.mode = s
+	o.presenter.SetOutput(s)
+	return nil
+}
+
+func (o *outputValue) String() string {
+	return o.mode
+}
+
+func (o *outputValue) Type() string {
+	return "output"
+}
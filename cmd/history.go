@@ -0,0 +1,92 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/phantompunk/kata/internal/app"
+	"github.com/phantompunk/kata/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+func newHistoryCmd(kata *app.App) *cobra.Command {
+	var language string
+	var show, diff int
+
+	cmd := &cobra.Command{
+		Use:   "history <slug> [attempt]",
+		Short: "List past test runs and submissions of a problem",
+		Example: `  kata history two-sum
+  kata history two-sum --show 3
+  kata history two-sum --diff 2 3
+  kata history two-sum --diff 2    # compare with the current solution`,
+		PreRunE: validateLanguagePreRun(kata, &language),
+		RunE:    handleErrors(kata, historyFunc(kata, &language, &show, &diff)),
+		Args:    historyArgs,
+	}
+
+	cmd.Flags().StringVarP(&language, "language", "l", "", "Programming language to use")
+	cmd.Flags().IntVar(&show, "show", 0, "Print the code of an attempt")
+	cmd.Flags().IntVar(&diff, "diff", 0, "Diff an attempt against a later one or the current solution")
+	cmd.MarkFlagsMutuallyExclusive("show", "diff")
+
+	return cmd
+}
+
+func historyFunc(kata *app.App, language *string, show, diff *int) CommandFunc {
+	return func(cmd *cobra.Command, args []string) error {
+		presenter := ui.PresenterFrom(cmd.Context())
+		problem, err := findProblem(cmd, kata, presenter, args[0], *language)
+		if err != nil || problem == nil {
+			return err
+		}
+
+		switch {
+		case *show != 0:
+			attempt, err := kata.Question.Attempt(cmd.Context(), problem, *show)
+			if err != nil {
+				return err
+			}
+			presenter.ShowAttemptCode(attempt)
+
+		case *diff != 0:
+			to := 0
+			if len(args) == 2 {
+				to, _ = strconv.Atoi(args[1])
+			}
+
+			changes, err := kata.Question.DiffAttempts(cmd.Context(), problem, *diff, to)
+			if err != nil {
+				return err
+			}
+			presenter.ShowDiff(changes)
+
+		default:
+			attempts, err := kata.Question.History(cmd.Context(), problem)
+			if err != nil {
+				return err
+			}
+			presenter.ShowHistory(problem.Title, attempts)
+		}
+		return nil
+	}
+}
+
+// historyArgs accepts a second attempt number only as the newer side of --diff
+func historyArgs(cmd *cobra.Command, args []string) error {
+	if err := cobra.RangeArgs(1, 2)(cmd, args); err != nil {
+		return err
+	}
+	if len(args) < 2 {
+		return nil
+	}
+
+	if !cmd.Flags().Changed("diff") {
+		return errors.New("a second attempt number can only be used with --diff")
+	}
+	if _, err := strconv.Atoi(args[1]); err != nil {
+		return fmt.Errorf("invalid attempt number %q", args[1])
+	}
+	return nil
+}
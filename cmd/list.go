@@ -2,37 +2,114 @@ package cmd
 
 import (
 	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/phantompunk/kata/internal/app"
+	"github.com/phantompunk/kata/internal/config"
+	"github.com/phantompunk/kata/internal/domain"
+	"github.com/phantompunk/kata/internal/repository"
 	"github.com/phantompunk/kata/internal/table"
+	"github.com/phantompunk/kata/internal/ui"
 	"github.com/spf13/cobra"
 )
 
 func newListCmd(kata *app.App) *cobra.Command {
+	var filter repository.ListFilter
+	var tracks []string
+	var height int
+	var format string
+
 	cmd := &cobra.Command{
 		Use:   "list",
 		Short: "Show all completed Leetcode problems",
-		RunE:  handleErrors(kata, listFunc(kata)),
+		Example: `  kata list --difficulty medium --unsolved --track go
+  kata list --sort last-attempted --search tree
+  kata list --format markdown > PROGRESS.md`,
+		PreRunE: listPreRun(&filter, &tracks, &height, &format),
+		RunE:    handleErrors(kata, listFunc(kata, &filter, &tracks, &height, &format)),
+		Args:    cobra.NoArgs,
 	}
 
+	cmd.Flags().StringVarP(&filter.Difficulty, "difficulty", "d", "", "Only easy, medium or hard problems")
+	cmd.Flags().BoolVarP(&filter.Unsolved, "unsolved", "u", false, "Only problems not yet solved in a shown track")
+	cmd.Flags().StringSliceVarP(&tracks, "track", "t", nil, "Languages to show instead of the configured tracks")
+	cmd.Flags().StringVar(&filter.Sort, "sort", repository.SortID, "Sort by "+strings.Join(repository.ListSorts, ", "))
+	cmd.Flags().StringVarP(&filter.Search, "search", "s", "", "Only problems whose title contains the text")
+	cmd.Flags().IntVar(&height, "height", 0, "Number of rows to show at once (default from config)")
+	cmd.Flags().StringVarP(&format, "format", "f", "", "Output as "+strings.Join(table.Formats, ", ")+" (default table in a terminal, plain otherwise)")
+
 	return cmd
 }
 
-func listFunc(kata *app.App) CommandFunc {
+func listFunc(kata *app.App, filter *repository.ListFilter, tracks *[]string, height *int, format *string) CommandFunc {
 	return func(cmd *cobra.Command, args []string) error {
 		opts := app.AppOptions{
 			Tracks: kata.Config.Tracks,
 		}
+		if len(*tracks) > 0 {
+			opts.Tracks = *tracks
+		}
 
-		questions, err := kata.Question.GetAllQuestionsWithStatus(cmd.Context(), opts)
+		questions, err := kata.Question.GetAllQuestionsWithStatus(cmd.Context(), opts, *filter)
 		if err != nil {
 			return fmt.Errorf("listing questions: %w", err)
 		}
 
-		if err := table.Render(questions, kata.Config.Tracks); err != nil {
+		if presenter := ui.PresenterFrom(cmd.Context()); presenter.JSONOutput() {
+			presenter.ShowResult(table.ToJSON(questions, opts.Tracks))
+			return nil
+		}
+
+		// Piped output and CI have no terminal to drive the interactive table
+		output := *format
+		if output == "" {
+			output = table.FormatPlain
+			if isTerminal(cmd.OutOrStdout()) {
+				output = table.FormatTable
+			}
+		}
+
+		if output != table.FormatTable {
+			if err := table.Write(cmd.OutOrStdout(), output, questions, opts.Tracks); err != nil {
+				return fmt.Errorf("writing questions as %s: %w", output, err)
+			}
+			return nil
+		}
+
+		rows := kata.Config.ListHeight
+		if *height > 0 {
+			rows = *height
+		}
+
+		if err := table.Render(questions, opts.Tracks, rows); err != nil {
 			return fmt.Errorf("rendering questions as table: %w", err)
 		}
 
 		return nil
 	}
 }
+
+func listPreRun(filter *repository.ListFilter, tracks *[]string, height *int, format *string) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		if !slices.Contains(repository.ListSorts, filter.Sort) {
+			return fmt.Errorf("invalid sort %q, use one of %s", filter.Sort, strings.Join(repository.ListSorts, ", "))
+		}
+		if *format != "" && !slices.Contains(table.Formats, *format) {
+			return fmt.Errorf("invalid format %q, use one of %s", *format, strings.Join(table.Formats, ", "))
+		}
+		if *format != "" && *format != table.FormatJSON && ui.PresenterFrom(cmd.Context()).JSONOutput() {
+			return fmt.Errorf("--format %s cannot be combined with --output json", *format)
+		}
+		if cmd.Flags().Changed("height") && *height < domain.MinListHeight {
+			return fmt.Errorf("height must be at least %d", domain.MinListHeight)
+		}
+
+		for _, track := range *tracks {
+			if _, err := config.NormalizeLanguage(track); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
@@ -24,16 +24,12 @@ func newLoginCmd(kata *app.App) *cobra.Command {
 
 func loginFunc(kata *app.App, force *bool) CommandFunc {
 	return func(cmd *cobra.Command, args []string) error {
-		presenter := ui.NewPresenter()
+		presenter := ui.PresenterFrom(cmd.Context())
 
 		if !*force {
 			if err := kata.Session.CheckSession(cmd.Context()); err == nil {
 				presenter.ShowAlreadyLoggedIn(kata.Config.Username)
-				res, err := kata.Question.GetStats(cmd.Context())
-				if err != nil {
-					return err
-				}
-				return presenter.ShowLoginResult(kata.Config.Username, res)
+				return showLoginResult(cmd, kata, presenter, kata.Config.Username)
 			}
 		}
 
@@ -47,11 +43,20 @@ func loginFunc(kata *app.App, force *bool) CommandFunc {
 		}
 		presenter.ShowAuthenticationSuccess()
 
-		res, err := kata.Question.GetStats(cmd.Context())
-		if err != nil {
-			return err
-		}
+		return showLoginResult(cmd, kata, presenter, username)
+	}
+}
 
-		return presenter.ShowLoginResult(username, res)
+func showLoginResult(cmd *cobra.Command, kata *app.App, presenter *ui.Presenter, username string) error {
+	res, err := kata.Question.GetStats(cmd.Context())
+	if err != nil {
+		return err
 	}
+
+	streak, err := kata.Question.GetDailyStreak(cmd.Context())
+	if err != nil {
+		return err
+	}
+
+	return presenter.ShowLoginResult(username, res, streak)
 }
@@ -3,41 +3,63 @@ package cmd
 import (
 	"errors"
 	"fmt"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/phantompunk/kata/internal/app"
+	"github.com/phantompunk/kata/internal/repository"
 	"github.com/phantompunk/kata/internal/ui"
 	"github.com/phantompunk/kata/pkg/editor"
 	"github.com/spf13/cobra"
 )
 
+var (
+	quizStatuses     = []string{repository.QuizUnsolved, repository.QuizFailed, repository.QuizSolved}
+	quizDifficulties = []string{"easy", "medium", "hard"}
+)
+
 func newQuizCmd(kata *app.App) *cobra.Command {
 	var open bool
 	var language string
+	var filter repository.QuizFilter
+	var notSeenFor ageValue
 
 	cmd := &cobra.Command{
-		Use:     "quiz",
-		Short:   "Select a random problem to complete",
-		PreRunE: validateLanguagePreRun(kata, &language),
-		RunE:    handleErrors(kata, quizFunc(kata, &open, &language)),
+		Use:   "quiz",
+		Short: "Select a random problem to complete",
+		Example: `  kata quiz --language rust --status unsolved
+  kata quiz --difficulty medium --tag graph --not-seen-for 14d --count 3`,
+		PreRunE: quizPreRun(kata, &language, &filter),
+		RunE:    handleErrors(kata, quizFunc(kata, &open, &language, &filter, &notSeenFor)),
+		Args:    cobra.NoArgs,
 	}
 
 	cmd.Flags().BoolVarP(&open, "open", "o", false, "Open problem with $EDITOR")
 	cmd.Flags().StringVarP(&language, "language", "l", "", "Programming language to use")
+	cmd.Flags().StringVarP(&filter.Difficulty, "difficulty", "d", "", "Only easy, medium or hard problems")
+	cmd.Flags().StringVarP(&filter.Tag, "tag", "t", "", "Only problems with this topic tag, requires kata sync")
+	cmd.Flags().StringVarP(&filter.Status, "status", "s", "", "Only unsolved, failed or solved problems in the language")
+	cmd.Flags().Var(&notSeenFor, "not-seen-for", "Only problems not attempted for this long, e.g. 14d")
+	cmd.Flags().IntVarP(&filter.Limit, "count", "n", 1, "Number of problems to pick")
+	cmd.MarkFlagsMutuallyExclusive("open", "count")
 
 	return cmd
 }
 
-func quizFunc(kata *app.App, open *bool, language *string) CommandFunc {
+func quizFunc(kata *app.App, open *bool, language *string, filter *repository.QuizFilter, notSeenFor *ageValue) CommandFunc {
 	return func(cmd *cobra.Command, args []string) error {
+		filter.NotSeenFor = time.Duration(*notSeenFor)
+
 		opts := app.AppOptions{
 			Workspace: kata.Config.WorkspacePath(),
 			Language:  *language,
 			Open:      *open,
 		}
 
-		presenter := ui.NewPresenter()
+		presenter := ui.PresenterFrom(cmd.Context())
 
-		problem, err := kata.Question.GetRandomQuestion(cmd.Context(), opts)
+		problems, err := kata.Question.GetQuizQuestions(cmd.Context(), opts, *filter)
 		if err != nil {
 			if errors.Is(err, app.ErrNoQuestions) {
 				presenter.ShowNoEligibleProblems()
@@ -47,6 +69,17 @@ func quizFunc(kata *app.App, open *bool, language *string) CommandFunc {
 			return err
 		}
 
+		if len(problems) == 0 {
+			presenter.ShowNoEligibleProblems()
+			return nil
+		}
+
+		if len(problems) > 1 {
+			presenter.ShowQuizResults(problems)
+			return nil
+		}
+
+		problem := problems[0]
 		if err := presenter.ShowQuizResult(problem); err != nil {
 			return err
 		}
@@ -65,3 +98,19 @@ func quizFunc(kata *app.App, open *bool, language *string) CommandFunc {
 		return nil
 	}
 }
+
+func quizPreRun(kata *app.App, language *string, filter *repository.QuizFilter) func(cmd *cobra.Command, args []string) error {
+	validateLanguage := validateLanguagePreRun(kata, language)
+	return func(cmd *cobra.Command, args []string) error {
+		if filter.Status != "" && !slices.Contains(quizStatuses, filter.Status) {
+			return fmt.Errorf("invalid status %q, use one of unsolved, failed or solved", filter.Status)
+		}
+		if filter.Difficulty != "" && !slices.Contains(quizDifficulties, strings.ToLower(filter.Difficulty)) {
+			return fmt.Errorf("invalid difficulty %q, use one of easy, medium or hard", filter.Difficulty)
+		}
+		if filter.Limit < 1 {
+			return fmt.Errorf("count must be at least 1")
+		}
+		return validateLanguage(cmd, args)
+	}
+}
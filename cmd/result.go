@@ -0,0 +1,98 @@
+package cmd
+
+import (
+	"errors"
+	"time"
+
+	"github.com/phantompunk/kata/internal/app"
+	"github.com/phantompunk/kata/internal/domain"
+	"github.com/phantompunk/kata/internal/leetcode"
+	"github.com/phantompunk/kata/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+func newResultCmd(kata *app.App) *cobra.Command {
+	var timeout time.Duration
+
+	cmd := &cobra.Command{
+		Use:   "result <submission-id>",
+		Short: "Fetch the verdict of a test or submission that timed out",
+		RunE:  handleErrors(kata, resultFunc(kata, &timeout)),
+		Args:  cobra.ExactArgs(1),
+	}
+
+	cmd.Flags().DurationVar(&timeout, "timeout", 0, "How long to wait for a verdict (default from config)")
+
+	return cmd
+}
+
+func resultFunc(kata *app.App, timeout *time.Duration) CommandFunc {
+	return func(cmd *cobra.Command, args []string) error {
+		presenter := ui.PresenterFrom(cmd.Context())
+		submissionId := args[0]
+
+		opts := app.AppOptions{
+			Workspace: kata.Config.WorkspacePath(),
+		}
+
+		wait := resultTimeout(kata, *timeout)
+		done := make(chan struct{})
+		go presenter.ShowWaitForResults(time.Now(), wait, done)
+
+		problem, result, err := kata.Question.CheckResult(cmd.Context(), submissionId, opts, wait)
+		close(done)
+		if err != nil {
+			return handleResultError(presenter, submissionId, err)
+		}
+
+		if result.IsSolution {
+			presenter.ShowSubmissionResults(result)
+			if result.IsAccepted() {
+				if err := stopSolveTimer(cmd, kata, presenter, problem); err != nil {
+					return err
+				}
+				return rateSolution(cmd, kata, presenter, problem, "")
+			}
+			return nil
+		}
+
+		presenter.ShowTestResults(result, problem)
+		return nil
+	}
+}
+
+// awaitResult polls for a verdict while showing progress. A nil result
+// without an error means the verdict is still pending on LeetCode.
+func awaitResult(cmd *cobra.Command, kata *app.App, presenter *ui.Presenter, problem *domain.Problem, submissionId string, timeout time.Duration) (*leetcode.SubmissionResult, error) {
+	wait := resultTimeout(kata, timeout)
+
+	done := make(chan struct{})
+	go presenter.ShowWaitForResults(time.Now(), wait, done)
+
+	result, err := kata.Question.WaitForResult(cmd.Context(), problem, submissionId, wait)
+	close(done)
+	if err != nil {
+		return nil, handleResultError(presenter, submissionId, err)
+	}
+
+	return result, nil
+}
+
+func handleResultError(presenter *ui.Presenter, submissionId string, err error) error {
+	switch {
+	case errors.Is(err, app.ErrResultTimeout):
+		presenter.ShowResultPending(submissionId)
+		return nil
+	case errors.Is(err, app.ErrSolutionFailed):
+		presenter.ShowSolutionFailed()
+	}
+	return err
+}
+
+// resultTimeout prefers the --timeout flag over the configured wait
+func resultTimeout(kata *app.App, flag time.Duration) time.Duration {
+	if flag > 0 {
+		return flag
+	}
+	return kata.Config.ResultWait()
+}
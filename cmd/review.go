@@ -0,0 +1,106 @@
+package cmd
+
+import (
+	"bufio"
+	"io"
+	"os"
+	"time"
+
+	"github.com/phantompunk/kata/internal/app"
+	"github.com/phantompunk/kata/internal/domain"
+	"github.com/phantompunk/kata/internal/srs"
+	"github.com/phantompunk/kata/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+func newReviewCmd(kata *app.App) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "review",
+		Short: "List solved problems due for review today",
+		RunE:  handleErrors(kata, reviewFunc(kata)),
+		Args:  cobra.NoArgs,
+	}
+
+	return cmd
+}
+
+func reviewFunc(kata *app.App) CommandFunc {
+	return func(cmd *cobra.Command, args []string) error {
+		presenter := ui.PresenterFrom(cmd.Context())
+
+		reviews, err := kata.Question.DueReviews(cmd.Context())
+		if err != nil {
+			return err
+		}
+
+		if len(reviews) == 0 {
+			next, err := kata.Question.NextReviewDate(cmd.Context())
+			if err != nil {
+				return err
+			}
+			presenter.ShowNothingDue(next)
+			return nil
+		}
+
+		presenter.ShowReviews(reviews)
+		return nil
+	}
+}
+
+// rateSolution reschedules an accepted problem using the --rate flag or, in a
+// terminal with text output, the user's answer to a prompt. Otherwise it
+// defaults to good. Problems that are not due keep their schedule.
+func rateSolution(cmd *cobra.Command, kata *app.App, presenter *ui.Presenter, problem *domain.Problem, rate string) error {
+	card, err := kata.Question.ReviewCard(cmd.Context(), problem)
+	if err != nil {
+		return err
+	}
+	if !card.IsDue(time.Now()) {
+		presenter.ShowReviewNotDue(card.Due)
+		return nil
+	}
+
+	grade := srs.Good
+	switch {
+	case rate != "":
+		parsed, err := srs.ParseGrade(rate)
+		if err != nil {
+			return err
+		}
+		grade = parsed
+	case isTerminal(cmd.InOrStdin()) && !presenter.JSONOutput():
+		grade = promptGrade(cmd.InOrStdin(), presenter)
+	}
+
+	card, err = kata.Question.ReviewProblem(cmd.Context(), problem, grade)
+	if err != nil {
+		return err
+	}
+	presenter.ShowNextReview(card.Due, card.Interval)
+	return nil
+}
+
+// promptGrade asks until it reads a valid grade, an empty answer means good
+func promptGrade(in io.Reader, presenter *ui.Presenter) srs.Grade {
+	scanner := bufio.NewScanner(in)
+	for {
+		presenter.ShowGradePrompt()
+		if !scanner.Scan() || scanner.Text() == "" {
+			return srs.Good
+		}
+
+		if grade, err := srs.ParseGrade(scanner.Text()); err == nil && grade != srs.Again {
+			return grade
+		}
+	}
+}
+
+// isTerminal reports whether stdin or stdout is attached to a terminal
+func isTerminal(stream any) bool {
+	f, ok := stream.(*os.File)
+	if !ok {
+		return false
+	}
+	info, err := f.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
@@ -3,6 +3,7 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"os"
 	"os/signal"
 	"syscall"
 
@@ -23,26 +24,37 @@ func Execute() error {
 		return err
 	}
 
-	displayWarnings(kata.Setting.GetWarnings())
-
-	rootCmd := newRootCmd(kata)
-	return rootCmd.ExecuteContext(ctx)
+	presenter := ui.NewPresenter()
+	rootCmd := newRootCmd(kata, presenter)
+	err = rootCmd.ExecuteContext(ui.WithPresenter(ctx, presenter))
+	if presenter.JSONOutput() {
+		if writeErr := presenter.WriteJSON(os.Stdout, err); writeErr != nil {
+			return writeErr
+		}
+	}
+	return err
 }
 
-func newRootCmd(kata *app.App) *cobra.Command {
+func newRootCmd(kata *app.App, presenter *ui.Presenter) *cobra.Command {
 	version, commit := vcs.Version()
 	vt := fmt.Sprintf("%s versions %s (%s)\n", "kata", version, commit)
 
+	output := outputValue{mode: ui.OutputText, presenter: presenter}
 	rootCmd := &cobra.Command{
 		Use:           "kata",
 		Short:         "CLI for practicing Leetcode",
 		SilenceUsage:  true,
 		SilenceErrors: true,
 		Version:       version,
+		// Runs once flags are parsed, so config warnings follow the output mode
+		PersistentPreRun: func(cmd *cobra.Command, args []string) {
+			presenter.ShowWarnings(kata.Setting.GetWarnings())
+		},
 	}
 
 	rootCmd.SetVersionTemplate(vt)
 	rootCmd.PersistentFlags().BoolP("verbose", "v", false, "Enable verbose output")
+	rootCmd.PersistentFlags().Var(&output, "output", "Output format, text or json")
 
 	rootCmd.AddCommand(newDownloadCmd(kata))
 	rootCmd.AddCommand(newQuizCmd(kata))
@@ -51,6 +63,16 @@ func newRootCmd(kata *app.App) *cobra.Command {
 	rootCmd.AddCommand(newTestCmd(kata))
 	rootCmd.AddCommand(newSubmitCmd(kata))
 	rootCmd.AddCommand(newSettingsCmd(kata))
+	rootCmd.AddCommand(newSyncCmd(kata))
+	rootCmd.AddCommand(newCatalogCmd(kata))
+	rootCmd.AddCommand(newDailyCmd(kata))
+	rootCmd.AddCommand(newResultCmd(kata))
+	rootCmd.AddCommand(newTestcaseCmd(kata))
+	rootCmd.AddCommand(newHistoryCmd(kata))
+	rootCmd.AddCommand(newReviewCmd(kata))
+	rootCmd.AddCommand(newBrowseCmd(kata))
+	rootCmd.AddCommand(newShowCmd(kata))
+	rootCmd.AddCommand(newSolveCmd(kata))
 
 	return rootCmd
 }
@@ -62,13 +84,13 @@ func handleErrors(kata *app.App, fn CommandFunc) CommandFunc {
 			return nil
 		}
 
-		if v, _ := cmd.Flags().GetBool("verbose"); v || kata.Config.Verbose {
+		verbose, _ := cmd.Flags().GetBool("verbose")
+		verbose = verbose || kata.Config.Verbose
+		ui.PresenterFrom(cmd.Context()).RecordError(err, verbose)
+
+		if verbose {
 			return fmt.Errorf("error %+v", err)
 		}
 		return fmt.Errorf("%s", ui.FormatError(err))
 	}
 }
-
-func displayWarnings(warnings []string) {
-	ui.ShowWarnings(warnings)
-}
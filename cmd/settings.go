@@ -18,7 +18,7 @@ func newSettingsCmd(kata *app.App) *cobra.Command {
 
 func settingsFunc(kata *app.App) CommandFunc {
 	return func(cmd *cobra.Command, args []string) error {
-		presenter := ui.NewPresenter()
+		presenter := ui.PresenterFrom(cmd.Context())
 		presenter.ShowOpeningConfigFile(kata.Setting.GetPath())
 		return kata.Setting.EditConfig()
 	}
@@ -0,0 +1,67 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/phantompunk/kata/internal/app"
+	"github.com/phantompunk/kata/internal/domain"
+	"github.com/phantompunk/kata/internal/render"
+	"github.com/phantompunk/kata/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+func newShowCmd(kata *app.App) *cobra.Command {
+	var language string
+
+	cmd := &cobra.Command{
+		Use:     "show <slug>",
+		Short:   "Show a problem's statement, examples and constraints",
+		Example: "  kata show two-sum\n  kata show two-sum --language go",
+		PreRunE: validateLanguagePreRun(kata, &language),
+		RunE:    handleErrors(kata, showFunc(kata, &language)),
+		Args:    cobra.ExactArgs(1),
+	}
+
+	cmd.Flags().StringVarP(&language, "language", "l", "", "Language of the last verdict to show")
+
+	return cmd
+}
+
+func showFunc(kata *app.App, language *string) CommandFunc {
+	return func(cmd *cobra.Command, args []string) error {
+		presenter := ui.PresenterFrom(cmd.Context())
+		problemName, err := kata.Catalog.ResolveSlug(cmd.Context(), args[0])
+		if err != nil {
+			return err
+		}
+
+		opts := app.AppOptions{
+			Problem:   problemName,
+			Language:  *language,
+			Workspace: kata.Config.WorkspacePath(),
+			IsPremium: kata.Config.IsPremium,
+		}
+
+		problem, err := fetchProblem(cmd, kata, presenter, opts)
+		if err != nil || problem == nil {
+			return err
+		}
+
+		statement, err := render.Markdown(problem.Content)
+		if err != nil {
+			return err
+		}
+
+		var last *domain.Attempt
+		attempt, err := kata.Question.LastAttempt(cmd.Context(), problem)
+		if err == nil {
+			last = &attempt
+		} else if !errors.Is(err, app.ErrNoAttempts) {
+			return fmt.Errorf("getting last attempt: %w", err)
+		}
+
+		presenter.ShowProblem(problem, statement, last)
+		return nil
+	}
+}
@@ -0,0 +1,99 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/phantompunk/kata/internal/app"
+	"github.com/phantompunk/kata/internal/domain"
+	"github.com/phantompunk/kata/internal/ui"
+	"github.com/phantompunk/kata/pkg/editor"
+	"github.com/spf13/cobra"
+)
+
+func newSolveCmd(kata *app.App) *cobra.Command {
+	var language string
+	var reset bool
+
+	cmd := &cobra.Command{
+		Use:   "solve <slug>",
+		Short: "Start an attempt at a problem and open the solution in $EDITOR",
+		Long: `Start an attempt at a problem: stub it if needed, start a timer and open the
+solution in $EDITOR. An existing solution is kept unless --reset is given, the
+timer stops when a submission is accepted.`,
+		Example: "  kata solve two-sum\n  kata solve two-sum --reset",
+		PreRunE: validateLanguagePreRun(kata, &language),
+		RunE:    handleErrors(kata, solveFunc(kata, &language, &reset)),
+		Args:    cobra.ExactArgs(1),
+	}
+
+	cmd.Flags().StringVarP(&language, "language", "l", "", "Programming language to use")
+	cmd.Flags().BoolVarP(&reset, "reset", "r", false, "Start over from the template, the current code is kept in history")
+
+	return cmd
+}
+
+func solveFunc(kata *app.App, language *string, reset *bool) CommandFunc {
+	return func(cmd *cobra.Command, args []string) error {
+		presenter := ui.PresenterFrom(cmd.Context())
+		problemName, err := kata.Catalog.ResolveSlug(cmd.Context(), args[0])
+		if err != nil {
+			return err
+		}
+
+		opts := app.AppOptions{
+			Problem:   problemName,
+			Language:  *language,
+			Workspace: kata.Config.WorkspacePath(),
+			IsPremium: kata.Config.IsPremium,
+		}
+
+		problem, err := fetchProblem(cmd, kata, presenter, opts)
+		if err != nil || problem == nil {
+			return err
+		}
+
+		switch {
+		case !problem.SolutionExists():
+			result, err := kata.Question.Stub(cmd.Context(), problem, opts)
+			if err != nil {
+				return fmt.Errorf("stubbing question %q: %w", opts.Problem, err)
+			}
+			presenter.ShowRenderResults(result, problem.Slug, false)
+		case *reset:
+			opts.Retry = true
+			if _, err := kata.Question.Stub(cmd.Context(), problem, opts); err != nil {
+				return fmt.Errorf("failed to reset solution file: %w", err)
+			}
+			presenter.ShowSolutionReset(problem.Slug)
+		default:
+			presenter.ShowSolutionKept(problem.SolutionPath())
+		}
+
+		started, err := kata.Question.StartTimer(cmd.Context(), problem)
+		if err != nil {
+			return err
+		}
+		presenter.ShowTimerStarted(problem, started)
+
+		// Scripts reading json output have no terminal to hand to the editor
+		if presenter.JSONOutput() {
+			return nil
+		}
+
+		if err := editor.Open(problem.SolutionPath()); err != nil {
+			return fmt.Errorf("failed to open solution file in editor: %w", err)
+		}
+		return nil
+	}
+}
+
+// stopSolveTimer shows how long an accepted attempt took when it was
+// started with kata solve
+func stopSolveTimer(cmd *cobra.Command, kata *app.App, presenter *ui.Presenter, problem *domain.Problem) error {
+	elapsed, ok, err := kata.Question.StopTimer(cmd.Context(), problem)
+	if err != nil || !ok {
+		return err
+	}
+	presenter.ShowSolveTime(elapsed)
+	return nil
+}
@@ -10,25 +10,31 @@ import (
 )
 
 func newSubmitCmd(kata *app.App) *cobra.Command {
-	var language string
+	var language, rate string
+	var timeout time.Duration
 
 	cmd := &cobra.Command{
 		Use:     "submit",
 		Short:   "Submit solutions against leetcode servers",
 		PreRunE: validateLanguagePreRun(kata, &language),
-		RunE:    handleErrors(kata, submitFunc(kata, &language)),
+		RunE:    handleErrors(kata, submitFunc(kata, &language, &rate, &timeout)),
 		Args:    cobra.ExactArgs(1),
 	}
 
 	cmd.Flags().StringVarP(&language, "language", "l", "", "Programming language to use")
+	cmd.Flags().DurationVar(&timeout, "timeout", 0, "How long to wait for a verdict (default from config)")
+	cmd.Flags().StringVar(&rate, "rate", "", "Rate an accepted solution without prompting: again, hard, good or easy")
 
 	return cmd
 }
 
-func submitFunc(kata *app.App, language *string) CommandFunc {
+func submitFunc(kata *app.App, language, rate *string, timeout *time.Duration) CommandFunc {
 	return func(cmd *cobra.Command, args []string) error {
-		problemName := app.ConvertToSlug(args[0])
-		presenter := ui.NewPresenter()
+		presenter := ui.PresenterFrom(cmd.Context())
+		problemName, err := kata.Catalog.ResolveSlug(cmd.Context(), args[0])
+		if err != nil {
+			return err
+		}
 
 		opts := app.AppOptions{
 			Problem:   problemName,
@@ -57,21 +63,18 @@ func submitFunc(kata *app.App, language *string) CommandFunc {
 		}
 		presenter.ShowSubmittingSolution()
 
-		startTime := time.Now()
-		maxWait := time.Duration(10) * time.Second
-
-		done := make(chan struct{})
-		go presenter.ShowWaitForResults(startTime, maxWait, done)
-
-		result, err := kata.Question.WaitForResult(cmd.Context(), problem, submissionId, maxWait)
-		if err != nil {
-			if errors.Is(err, app.ErrSolutionFailed) {
-				presenter.ShowSolutionFailed()
-			}
+		result, err := awaitResult(cmd, kata, presenter, problem, submissionId, *timeout)
+		if err != nil || result == nil {
 			return err
 		}
 
 		presenter.ShowSubmissionResults(result)
+		if result.IsAccepted() {
+			if err := stopSolveTimer(cmd, kata, presenter, problem); err != nil {
+				return err
+			}
+			return rateSolution(cmd, kata, presenter, problem, *rate)
+		}
 		return nil
 	}
 }
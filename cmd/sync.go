@@ -0,0 +1,35 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/phantompunk/kata/internal/app"
+	"github.com/phantompunk/kata/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+func newSyncCmd(kata *app.App) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sync",
+		Short: "Download the Leetcode problem catalog for offline lookups",
+		RunE:  handleErrors(kata, syncFunc(kata)),
+		Args:  cobra.NoArgs,
+	}
+
+	return cmd
+}
+
+func syncFunc(kata *app.App) CommandFunc {
+	return func(cmd *cobra.Command, args []string) error {
+		presenter := ui.PresenterFrom(cmd.Context())
+		presenter.ShowSyncingCatalog()
+
+		synced, err := kata.Catalog.Sync(cmd.Context(), presenter.ShowSyncProgress)
+		if err != nil {
+			return fmt.Errorf("syncing catalog: %w", err)
+		}
+
+		presenter.ShowCatalogSynced(synced)
+		return nil
+	}
+}
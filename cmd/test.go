@@ -5,35 +5,46 @@ import (
 	"time"
 
 	"github.com/phantompunk/kata/internal/app"
+	"github.com/phantompunk/kata/internal/runner"
 	"github.com/phantompunk/kata/internal/ui"
 	"github.com/spf13/cobra"
 )
 
 func newTestCmd(kata *app.App) *cobra.Command {
 	var language string
+	var local, onlyCustom bool
+	var timeout time.Duration
 
 	cmd := &cobra.Command{
 		Use:     "test",
 		Short:   "Runs problem solution against leetcode test cases",
 		PreRunE: validateLanguagePreRun(kata, &language),
-		RunE:    handleErrors(kata, testFunc(kata, &language)),
+		RunE:    handleErrors(kata, testFunc(kata, &language, &local, &onlyCustom, &timeout)),
 		Args:    cobra.ExactArgs(1),
 	}
 
 	cmd.Flags().StringVarP(&language, "language", "l", "", "Programming language to use")
+	cmd.Flags().BoolVar(&local, "local", false, "Run the generated test file with the local toolchain")
+	cmd.Flags().BoolVar(&onlyCustom, "only-custom", false, "Test only the custom test cases, skipping the examples")
+	cmd.Flags().DurationVar(&timeout, "timeout", 0, "How long to wait for a verdict (default from config)")
+	cmd.MarkFlagsMutuallyExclusive("local", "only-custom")
 
 	return cmd
 }
 
-func testFunc(kata *app.App, language *string) CommandFunc {
+func testFunc(kata *app.App, language *string, local, onlyCustom *bool, timeout *time.Duration) CommandFunc {
 	return func(cmd *cobra.Command, args []string) error {
-		problemName := app.ConvertToSlug(args[0])
-		presenter := ui.NewPresenter()
+		presenter := ui.PresenterFrom(cmd.Context())
+		problemName, err := kata.Catalog.ResolveSlug(cmd.Context(), args[0])
+		if err != nil {
+			return err
+		}
 
 		opts := app.AppOptions{
-			Language:  *language,
-			Problem:   problemName,
-			Workspace: kata.Config.WorkspacePath(),
+			Language:   *language,
+			Problem:    problemName,
+			Workspace:  kata.Config.WorkspacePath(),
+			OnlyCustom: *onlyCustom,
 		}
 
 		problem, err := kata.Question.GetBySlug(cmd.Context(), opts)
@@ -51,23 +62,29 @@ func testFunc(kata *app.App, language *string) CommandFunc {
 			return nil
 		}
 
+		if *local {
+			presenter.ShowRunningTests()
+			result, err := kata.Question.RunLocalTests(cmd.Context(), problem)
+			if err != nil {
+				if errors.Is(err, runner.ErrBuildFailed) {
+					presenter.ShowLocalTestOutput(result)
+					return nil
+				}
+				return err
+			}
+
+			presenter.ShowLocalTestResults(result)
+			return nil
+		}
+
 		submissionId, err := kata.Question.SubmitTest(cmd.Context(), problem, opts)
 		if err != nil {
 			return err
 		}
 		presenter.ShowRunningTests()
 
-		startTime := time.Now()
-		maxWait := time.Duration(10) * time.Second
-
-		done := make(chan struct{})
-		go presenter.ShowWaitForResults(startTime, maxWait, done)
-
-		result, err := kata.Question.WaitForResult(cmd.Context(), problem, submissionId, maxWait)
-		if err != nil {
-			if errors.Is(err, app.ErrSolutionFailed) {
-				presenter.ShowSolutionFailed()
-			}
+		result, err := awaitResult(cmd, kata, presenter, problem, submissionId, *timeout)
+		if err != nil || result == nil {
 			return err
 		}
 
@@ -0,0 +1,154 @@
+package cmd
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/phantompunk/kata/internal/app"
+	"github.com/phantompunk/kata/internal/domain"
+	"github.com/phantompunk/kata/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+func newTestcaseCmd(kata *app.App) *cobra.Command {
+	var language string
+
+	cmd := &cobra.Command{
+		Use:   "testcase",
+		Short: "Manage custom test cases sent with kata test",
+	}
+
+	cmd.PersistentFlags().StringVarP(&language, "language", "l", "", "Programming language to use")
+
+	cmd.AddCommand(newTestcaseAddCmd(kata, &language))
+	cmd.AddCommand(newTestcaseListCmd(kata, &language))
+	cmd.AddCommand(newTestcaseRemoveCmd(kata, &language))
+
+	return cmd
+}
+
+func newTestcaseAddCmd(kata *app.App, language *string) *cobra.Command {
+	var expected string
+
+	cmd := &cobra.Command{
+		Use:     "add <slug> <value>...",
+		Short:   "Add a test case, one value per parameter",
+		Example: `  kata testcase add two-sum '[3,3]' 6 --expected '[0,1]'`,
+		PreRunE: validateLanguagePreRun(kata, language),
+		RunE:    handleErrors(kata, testcaseAddFunc(kata, language, &expected)),
+		Args:    cobra.MinimumNArgs(2),
+	}
+
+	cmd.Flags().StringVarP(&expected, "expected", "e", "", "Expected output, used by local tests")
+
+	return cmd
+}
+
+func testcaseAddFunc(kata *app.App, language, expected *string) CommandFunc {
+	return func(cmd *cobra.Command, args []string) error {
+		presenter := ui.PresenterFrom(cmd.Context())
+		problem, err := findProblem(cmd, kata, presenter, args[0], *language)
+		if err != nil || problem == nil {
+			return err
+		}
+
+		testcase := domain.Testcase{Input: strings.Join(args[1:], "\n"), Expected: *expected}
+		if err := kata.Question.AddCustomCase(cmd.Context(), problem, testcase); err != nil {
+			return err
+		}
+
+		presenter.ShowTestcaseAdded(problem.Title, problem.TestcasesPath().DisplayPath())
+		return nil
+	}
+}
+
+func newTestcaseListCmd(kata *app.App, language *string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "list <slug>",
+		Short:   "List the custom test cases of a problem",
+		PreRunE: validateLanguagePreRun(kata, language),
+		RunE:    handleErrors(kata, testcaseListFunc(kata, language)),
+		Args:    cobra.ExactArgs(1),
+	}
+
+	return cmd
+}
+
+func testcaseListFunc(kata *app.App, language *string) CommandFunc {
+	return func(cmd *cobra.Command, args []string) error {
+		presenter := ui.PresenterFrom(cmd.Context())
+		problem, err := findProblem(cmd, kata, presenter, args[0], *language)
+		if err != nil || problem == nil {
+			return err
+		}
+
+		cases, err := kata.Question.CustomCases(cmd.Context(), problem)
+		if err != nil {
+			return err
+		}
+
+		presenter.ShowCustomTestcases(problem.Title, cases)
+		return nil
+	}
+}
+
+func newTestcaseRemoveCmd(kata *app.App, language *string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "rm <slug> <number>",
+		Short:   "Remove a custom test case by its number in kata testcase list",
+		PreRunE: validateLanguagePreRun(kata, language),
+		RunE:    handleErrors(kata, testcaseRemoveFunc(kata, language)),
+		Args:    cobra.ExactArgs(2),
+	}
+
+	return cmd
+}
+
+func testcaseRemoveFunc(kata *app.App, language *string) CommandFunc {
+	return func(cmd *cobra.Command, args []string) error {
+		presenter := ui.PresenterFrom(cmd.Context())
+		position, err := strconv.Atoi(args[1])
+		if err != nil {
+			return errors.Join(app.ErrTestcaseNotFound, err)
+		}
+
+		problem, err := findProblem(cmd, kata, presenter, args[0], *language)
+		if err != nil || problem == nil {
+			return err
+		}
+
+		removed, err := kata.Question.RemoveCustomCase(cmd.Context(), problem, position)
+		if err != nil {
+			return err
+		}
+
+		presenter.ShowTestcaseRemoved(position, removed)
+		return nil
+	}
+}
+
+// findProblem looks up a downloaded problem in the given language, showing
+// a message and returning nil when it does not exist
+func findProblem(cmd *cobra.Command, kata *app.App, presenter *ui.Presenter, name, language string) (*domain.Problem, error) {
+	problemName, err := kata.Catalog.ResolveSlug(cmd.Context(), name)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := app.AppOptions{
+		Problem:   problemName,
+		Language:  language,
+		Workspace: kata.Config.WorkspacePath(),
+	}
+
+	problem, err := kata.Question.GetBySlug(cmd.Context(), opts)
+	if err != nil {
+		if errors.Is(err, app.ErrQuestionNotFound) {
+			presenter.ShowProblemNotFound(problemName)
+			return nil, nil
+		}
+		return nil, err
+	}
+	return problem, nil
+}
@@ -12,30 +12,47 @@ import (
 )
 
 var (
-	ErrCookiesNotFound  = errors.New("session cookies not found")
-	ErrNotAuthenticated = errors.New("not authenticated")
-	ErrInvalidSession   = errors.New("session is not valid")
-	ErrDuplicateProblem = errors.New("question has already been downloaded")
-	ErrNoQuestions      = errors.New("no questions found in the database")
-	ErrQuestionNotFound = errors.New("question not found")
-	ErrSolutionFailed   = errors.New("solution failed")
-	ErrPaidOnlyProblem  = errors.New("problem requires premium subscription")
+	ErrCookiesNotFound   = errors.New("session cookies not found")
+	ErrNotAuthenticated  = errors.New("not authenticated")
+	ErrInvalidSession    = errors.New("session is not valid")
+	ErrDuplicateProblem  = errors.New("question has already been downloaded")
+	ErrNoQuestions       = errors.New("no questions found in the database")
+	ErrQuestionNotFound  = errors.New("question not found")
+	ErrSolutionFailed    = errors.New("solution failed")
+	ErrPaidOnlyProblem   = errors.New("problem requires premium subscription")
+	ErrUnknownProblemID  = errors.New("problem number not found in catalog")
+	ErrCatalogEmpty      = errors.New("problem catalog has not been synced")
+	ErrResultTimeout     = errors.New("timed out waiting for submission result")
+	ErrUnknownSubmission = errors.New("no pending submission with that id")
+	ErrInvalidTestcase   = errors.New("test case does not match the problem signature")
+	ErrTestcaseNotFound  = errors.New("custom test case not found")
+	ErrNoCustomTestcases = errors.New("problem has no custom test cases")
+	ErrNoAttempts        = errors.New("problem has no recorded attempts")
+	ErrAttemptNotFound   = errors.New("attempt not found")
+	ErrNoSnapshot        = errors.New("attempt has no saved code")
+	ErrNoReviews         = errors.New("no problems scheduled for review")
+	ErrNoSnippetMarkers  = errors.New("solution has no snippet markers")
+	ErrSharedNotFound    = errors.New("shared snippet not found")
+	ErrInvalidSharedName = errors.New("shared snippet name is not a file in the shared directory")
+	ErrEmptySnippet      = errors.New("snippet markers enclose no code")
 )
 
 type AppOptions struct {
-	Problem   string
-	Language  string
-	Tracks    []string
-	Workspace string
-	Open      bool
-	Force     bool
-	Retry     bool
-	IsPremium bool
+	Problem    string
+	Language   string
+	Tracks     []string
+	Workspace  string
+	Open       bool
+	Force      bool
+	Retry      bool
+	IsPremium  bool
+	OnlyCustom bool
 }
 
 type App struct {
 	Config   *config.Config
 	Question *QuestionService
+	Catalog  *CatalogService
 	Setting  *config.ConfigService
 	Session  *SessionService
 }
@@ -62,19 +79,30 @@ func New() (*App, error) {
 	}
 
 	repo := repository.New(db)
-	client := leetcode.NewClient(leetcode.WithSession(cfg.Session))
+	retry := leetcode.DefaultRetryPolicy()
+	retry.MaxRetries = cfg.MaxRetries
+
+	client := leetcode.NewClient(
+		leetcode.WithSession(cfg.Session),
+		leetcode.WithRetryPolicy(retry),
+		leetcode.WithRateLimit(cfg.RateLimit, leetcode.DefaultBurst),
+	)
 
 	download := NewQuestionService(repo, client, renderer)
+	catalog := NewCatalogService(db, client)
 	session := NewSessionService(cfg, client, settings)
 
 	return &App{
 		Config:   cfg,
 		Question: download,
+		Catalog:  catalog,
 		Setting:  settings,
 		Session:  session,
 	}, nil
 }
 
+// ConvertToSlug maps a problem number to its slug using the embedded mapping.
+// Prefer CatalogService.ResolveSlug, which consults the synced catalog first.
 func ConvertToSlug(name string) string {
 	if id, err := strconv.Atoi(name); err == nil {
 		return MapIDtoSlug[id]
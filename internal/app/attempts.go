@@ -0,0 +1,119 @@
+package app
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/phantompunk/kata/internal/domain"
+	"github.com/phantompunk/kata/internal/leetcode"
+	"github.com/phantompunk/kata/internal/repository"
+)
+
+// trackSubmission remembers a submission until its verdict is recorded, so
+// it can be checked later and its attempt linked to the submitted code
+func (s *QuestionService) trackSubmission(ctx context.Context, problem *domain.Problem, submissionId, code string) error {
+	if err := s.saveSnapshot(ctx, code); err != nil {
+		return err
+	}
+
+	err := s.repo.SavePendingSubmission(ctx, repository.SavePendingSubmissionParams{
+		SubmissionID: submissionId,
+		QuestionID:   int64(problem.GetID()),
+		LangSlug:     problem.Language.Slug(),
+		CreatedAt:    time.Now().Format(time.RFC3339),
+		CodeHash:     codeHash(code),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to save pending submission %s: %w", submissionId, err)
+	}
+	return nil
+}
+
+// recordAttempt adds a finished test run or submission to the attempt history
+func (s *QuestionService) recordAttempt(ctx context.Context, problem *domain.Problem, submissionId, hash string, result *leetcode.SubmissionResult) error {
+	kind := domain.AttemptTest
+	if result.IsSolution {
+		kind = domain.AttemptSubmit
+	}
+
+	err := s.repo.CreateAttempt(ctx, repository.CreateAttemptParams{
+		QuestionID:        int64(problem.GetID()),
+		LangSlug:          problem.Language.Slug(),
+		Kind:              kind,
+		Verdict:           result.Status.String(),
+		Runtime:           result.Runtime,
+		Memory:            result.Memory,
+		RuntimePercentile: nullFloat(result.RuntimePercentile),
+		MemoryPercentile:  nullFloat(result.MemoryPercentile),
+		SubmissionID:      submissionId,
+		CodeHash:          hash,
+		CreatedAt:         time.Now().Format(time.RFC3339),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to record attempt %s: %w", submissionId, err)
+	}
+	return nil
+}
+
+// snapshotSolution keeps the current solution before a retry overwrites it
+func (s *QuestionService) snapshotSolution(ctx context.Context, problem *domain.Problem) error {
+	code, err := s.extractor.ExtractSnippet(problem)
+	if errors.Is(err, ErrNoSnippetMarkers) {
+		// Keep the whole file, the retry would otherwise lose code written outside the markers
+		code, err = s.extractor.ReadSolution(problem.SolutionPath())
+	}
+	if errors.Is(err, ErrEmptySnippet) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read solution: %w", err)
+	}
+	if code == "" {
+		return nil
+	}
+
+	if err := s.saveSnapshot(ctx, code); err != nil {
+		return err
+	}
+
+	err = s.repo.CreateAttempt(ctx, repository.CreateAttemptParams{
+		QuestionID: int64(problem.GetID()),
+		LangSlug:   problem.Language.Slug(),
+		Kind:       domain.AttemptRetry,
+		CodeHash:   codeHash(code),
+		CreatedAt:  time.Now().Format(time.RFC3339),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to record retry snapshot: %w", err)
+	}
+	return nil
+}
+
+func (s *QuestionService) saveSnapshot(ctx context.Context, code string) error {
+	err := s.repo.SaveSnapshot(ctx, repository.SaveSnapshotParams{
+		CodeHash:  codeHash(code),
+		Code:      code,
+		CreatedAt: time.Now().Format(time.RFC3339),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to save code snapshot: %w", err)
+	}
+	return nil
+}
+
+func codeHash(code string) string {
+	sum := sha256.Sum256([]byte(code))
+	return hex.EncodeToString(sum[:])
+}
+
+func nullFloat(f *float64) sql.NullFloat64 {
+	if f == nil {
+		return sql.NullFloat64{}
+	}
+	return sql.NullFloat64{Float64: *f, Valid: true}
+}
@@ -0,0 +1,119 @@
+package app
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/phantompunk/kata/internal/domain"
+	"github.com/phantompunk/kata/internal/leetcode"
+	"github.com/phantompunk/kata/internal/repository"
+)
+
+const catalogPageSize = 100
+
+type CatalogService struct {
+	db     *sql.DB
+	repo   *repository.Queries
+	client leetcode.Client
+}
+
+func NewCatalogService(db *sql.DB, client leetcode.Client) *CatalogService {
+	return &CatalogService{db: db, repo: repository.New(db), client: client}
+}
+
+// Sync pages through LeetCode's problem list and stores every entry in the catalog
+func (s *CatalogService) Sync(ctx context.Context, progress func(synced, total int)) (int, error) {
+	synced := 0
+	for skip := 0; ; skip += catalogPageSize {
+		page, err := s.client.FetchProblemset(ctx, skip, catalogPageSize)
+		if err != nil {
+			return synced, fmt.Errorf("failed to fetch problem list: %w", err)
+		}
+
+		count, err := s.savePage(ctx, page.Questions)
+		if err != nil {
+			return synced, err
+		}
+		synced += count
+
+		if progress != nil {
+			progress(synced, page.Total)
+		}
+
+		if len(page.Questions) < catalogPageSize || skip+catalogPageSize >= page.Total {
+			return synced, nil
+		}
+	}
+}
+
+func (s *CatalogService) savePage(ctx context.Context, questions []leetcode.CatalogQuestion) (int, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	saved := 0
+	repo := s.repo.WithTx(tx)
+	for _, question := range questions {
+		params, ok := repository.ToCatalogParams(question)
+		if !ok {
+			continue
+		}
+
+		if err := repo.UpsertCatalog(ctx, params); err != nil {
+			return 0, fmt.Errorf("failed to save %q to catalog: %w", question.TitleSlug, err)
+		}
+		saved++
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit catalog page: %w", err)
+	}
+	return saved, nil
+}
+
+// List returns catalog entries matching the filter, requiring a prior sync
+func (s *CatalogService) List(ctx context.Context, filter repository.CatalogFilter) ([]domain.CatalogEntry, error) {
+	count, err := s.repo.CountCatalog(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count catalog: %w", err)
+	}
+
+	if count == 0 {
+		return nil, ErrCatalogEmpty
+	}
+
+	entries, err := s.repo.ListCatalog(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list catalog: %w", err)
+	}
+	return entries, nil
+}
+
+// ResolveSlug converts a problem number into its slug using the synced catalog,
+// falling back to the embedded mapping. Slugs are returned unchanged.
+func (s *CatalogService) ResolveSlug(ctx context.Context, name string) (string, error) {
+	id, err := strconv.Atoi(name)
+	if err != nil {
+		return name, nil
+	}
+
+	slug, err := s.repo.GetCatalogSlug(ctx, int64(id))
+	if err == nil {
+		return slug, nil
+	}
+
+	if !errors.Is(err, sql.ErrNoRows) {
+		return "", fmt.Errorf("failed to look up problem %d: %w", id, err)
+	}
+
+	if slug := ConvertToSlug(name); slug != "" {
+		return slug, nil
+	}
+
+	return "", fmt.Errorf("%w: %d", ErrUnknownProblemID, id)
+}
@@ -0,0 +1,87 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/phantompunk/kata/internal/leetcode"
+	"github.com/phantompunk/kata/internal/repository"
+)
+
+// dailyDateLayout matches the UTC dates LeetCode uses for daily challenges
+const dailyDateLayout = "2006-01-02"
+
+// GetDailyChallenge fetches today's challenge and records it in the daily history
+func (s *QuestionService) GetDailyChallenge(ctx context.Context) (*leetcode.DailyChallenge, error) {
+	daily, err := s.client.FetchDailyChallenge(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch daily challenge: %w", err)
+	}
+
+	questionID, err := strconv.ParseInt(daily.Question.ID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid daily question id %q: %w", daily.Question.ID, err)
+	}
+
+	err = s.repo.SaveDailyChallenge(ctx, repository.SaveDailyChallengeParams{
+		Date:       daily.Date,
+		QuestionID: questionID,
+		TitleSlug:  daily.Question.TitleSlug,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to save daily challenge: %w", err)
+	}
+
+	return daily, nil
+}
+
+// GetDailyStreak counts consecutive completed daily challenges up to today
+func (s *QuestionService) GetDailyStreak(ctx context.Context) (int, error) {
+	dates, err := s.repo.ListCompletedDailyDates(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get daily history: %w", err)
+	}
+	return dailyStreak(dates, time.Now()), nil
+}
+
+// completeDailyChallenge marks today's challenge solved when it is the problem
+// that was accepted, other problems leave the daily history unchanged
+func (s *QuestionService) completeDailyChallenge(ctx context.Context, slug string) error {
+	err := s.repo.CompleteDailyChallenge(ctx, repository.CompleteDailyChallengeParams{
+		Date:      time.Now().UTC().Format(dailyDateLayout),
+		TitleSlug: slug,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update daily streak: %w", err)
+	}
+	return nil
+}
+
+// dailyStreak walks completed dates, newest first, counting consecutive days.
+// A streak is still alive when today's challenge has not been solved yet.
+func dailyStreak(dates []string, now time.Time) int {
+	now = now.UTC()
+	day := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+	streak := 0
+	for i, date := range dates {
+		completed, err := time.Parse(dailyDateLayout, date)
+		if err != nil {
+			break
+		}
+
+		if i == 0 && completed.Equal(day.AddDate(0, 0, -1)) {
+			day = completed
+		}
+
+		if !completed.Equal(day) {
+			break
+		}
+
+		streak++
+		day = day.AddDate(0, 0, -1)
+	}
+	return streak
+}
@@ -0,0 +1,28 @@
+package app
+
+import (
+	"testing"
+	"time"
+
+	"github.com/phantompunk/kata/pkg/assert"
+)
+
+func TestDailyStreak(t *testing.T) {
+	now := time.Date(2026, 10, 17, 15, 0, 0, 0, time.UTC)
+
+	t.Run("No history", func(t *testing.T) {
+		assert.Equal(t, dailyStreak(nil, now), 0)
+	})
+
+	t.Run("Completed today", func(t *testing.T) {
+		assert.Equal(t, dailyStreak([]string{"2026-10-17", "2026-10-16", "2026-10-15", "2026-10-13"}, now), 3)
+	})
+
+	t.Run("Today not yet completed", func(t *testing.T) {
+		assert.Equal(t, dailyStreak([]string{"2026-10-16", "2026-10-15"}, now), 2)
+	})
+
+	t.Run("Streak broken", func(t *testing.T) {
+		assert.Equal(t, dailyStreak([]string{"2026-10-15", "2026-10-14"}, now), 0)
+	})
+}
@@ -0,0 +1,256 @@
+package app
+
+import (
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/phantompunk/kata/internal/domain"
+	"github.com/spf13/afero"
+)
+
+// legacyComment is the comment files were stubbed with before markers
+// followed each language's syntax
+const legacyComment = "//"
+
+type Extractor struct {
+	fs afero.Fs
+}
+
+func NewExtractor() *Extractor {
+	return &Extractor{fs: afero.NewOsFs()}
+}
+
+// ExtractSnippet returns the code kata submits for a problem: every region
+// between the snippet markers in order, with shared helpers included where
+// their marker appears. Markers may use the language's comment syntax or the
+// legacy //.
+func (e Extractor) ExtractSnippet(problem *domain.Problem) (string, error) {
+	snippet, err := e.Extract(problem)
+	if err != nil {
+		return "", err
+	}
+	return snippet.Code(), nil
+}
+
+// Extract is ExtractSnippet keeping where each line of the code came from
+func (e Extractor) Extract(problem *domain.Problem) (*Snippet, error) {
+	source := &source{
+		lang:     problem.Language,
+		shared:   problem.SharedPath().String(),
+		included: map[string]bool{},
+	}
+
+	parts, found, err := e.extract(problem.SolutionPath(), source, false)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, fmt.Errorf("%w: expected %q in %s", ErrNoSnippetMarkers, problem.Language.StartMarker(), problem.SolutionPath())
+	}
+	if len(parts) == 0 {
+		return nil, fmt.Errorf("%w: %s", ErrEmptySnippet, problem.SolutionPath())
+	}
+	return &Snippet{lines: joinParts(parts)}, nil
+}
+
+// Snippet is extracted code that remembers the file and line each of its
+// lines came from, so problems found in it point into the user's files
+type Snippet struct {
+	lines []sourceLine
+}
+
+type sourceLine struct {
+	text   string
+	path   string
+	number int
+	// offset is the whitespace trimmed from the start of the line, in bytes
+	offset int
+}
+
+func (s Snippet) Code() string {
+	texts := make([]string, len(s.lines))
+	for i, line := range s.lines {
+		texts[i] = line.text
+	}
+	return strings.Join(texts, "\n")
+}
+
+// Location returns the file, line and column a position in the code came
+// from, counting from one. Blank lines between regions report the line before them.
+func (s Snippet) Location(line, column int) (string, int, int) {
+	for i := min(line, len(s.lines)) - 1; i >= 0; i-- {
+		if s.lines[i].path != "" {
+			if i == line-1 {
+				column += s.lines[i].offset
+			}
+			return s.lines[i].path, s.lines[i].number, column
+		}
+	}
+	return "", line, column
+}
+
+// joinParts separates regions with a blank line
+func joinParts(parts [][]sourceLine) []sourceLine {
+	var lines []sourceLine
+	for i, part := range parts {
+		if i > 0 {
+			lines = append(lines, sourceLine{})
+		}
+		lines = append(lines, part...)
+	}
+	return lines
+}
+
+// trimPart drops the blank lines and outer whitespace around a region
+func trimPart(part []sourceLine) []sourceLine {
+	for len(part) > 0 && strings.TrimSpace(part[0].text) == "" {
+		part = part[1:]
+	}
+	for len(part) > 0 && strings.TrimSpace(part[len(part)-1].text) == "" {
+		part = part[:len(part)-1]
+	}
+	if len(part) == 0 {
+		return nil
+	}
+
+	part = slices.Clone(part)
+	text := strings.TrimLeftFunc(part[0].text, unicode.IsSpace)
+	part[0].offset += len(part[0].text) - len(text)
+	part[0].text = text
+	part[len(part)-1].text = strings.TrimRightFunc(part[len(part)-1].text, unicode.IsSpace)
+	return part
+}
+
+// ReadSolution returns a whole solution file, for files without markers
+func (e Extractor) ReadSolution(path string) (string, error) {
+	content, err := afero.ReadFile(e.fs, path)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(content)), nil
+}
+
+// source is what extraction needs to know about a solution and its helpers
+type source struct {
+	lang     domain.Language
+	shared   string
+	included map[string]bool
+}
+
+// extract returns the marked regions of a file and reports whether it has a
+// start marker. Helpers without markers are returned whole.
+func (e Extractor) extract(path string, source *source, helper bool) ([][]sourceLine, bool, error) {
+	content, err := afero.ReadFile(e.fs, path)
+	if err != nil {
+		return nil, false, err
+	}
+
+	lines := strings.Split(string(content), "\n")
+	found := slices.ContainsFunc(lines, func(line string) bool {
+		return isMarker(line, domain.MarkerStart, source.lang)
+	})
+
+	var parts [][]sourceLine
+	var region []sourceLine
+	add := func() {
+		if part := trimPart(region); part != nil {
+			parts = append(parts, part)
+		}
+		region = nil
+	}
+
+	inSnippet := helper && !found
+	for i, line := range lines {
+		if name, ok := markerArgument(line, domain.MarkerShared, source.lang); ok && name != "" {
+			included, err := e.include(name, source)
+			if err != nil {
+				return nil, false, err
+			}
+			// Within code the helper goes in place, otherwise it is its own part
+			if inSnippet && trimPart(region) != nil {
+				region = append(region, joinParts(included)...)
+			} else {
+				parts = append(parts, included...)
+			}
+			continue
+		}
+
+		if isMarker(line, domain.MarkerStart, source.lang) {
+			inSnippet = true
+			continue
+		}
+
+		if isMarker(line, domain.MarkerEnd, source.lang) {
+			add()
+			inSnippet = false
+			continue
+		}
+
+		if inSnippet {
+			region = append(region, sourceLine{text: line, path: path, number: i + 1})
+		}
+	}
+
+	// An unterminated region runs to the end of the file
+	add()
+	return parts, found, nil
+}
+
+// include returns a helper from the shared library, each helper is only
+// included once per submission
+func (e Extractor) include(name string, source *source) ([][]sourceLine, error) {
+	// Helpers are files directly in the shared directory, never paths out of it
+	if strings.ContainsAny(name, `/\`) || strings.Contains(name, "..") {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidSharedName, name)
+	}
+	if filepath.Ext(name) == "" {
+		name += source.lang.Extension()
+	}
+
+	path := filepath.Join(source.shared, name)
+	if !strings.HasPrefix(filepath.Clean(path), filepath.Clean(source.shared)+string(filepath.Separator)) {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidSharedName, name)
+	}
+	if source.included[path] {
+		return nil, nil
+	}
+	source.included[path] = true
+
+	if exists, _ := afero.Exists(e.fs, path); !exists {
+		return nil, fmt.Errorf("%w: %s", ErrSharedNotFound, path)
+	}
+
+	parts, _, err := e.extract(path, source, true)
+	return parts, err
+}
+
+// isMarker reports whether a line is the marker commented in the language's
+// syntax or with the legacy comment
+func isMarker(line, marker string, lang domain.Language) bool {
+	text, ok := markerArgument(line, marker, lang)
+	return ok && text == ""
+}
+
+// markerArgument matches a commented marker and returns the text after it,
+// like the helper name of a shared marker
+func markerArgument(line, marker string, lang domain.Language) (string, bool) {
+	line = strings.TrimSpace(line)
+	prefix, suffix := lang.Comment()
+
+	for _, comment := range []string{prefix, legacyComment} {
+		text, ok := strings.CutPrefix(line, comment)
+		if !ok {
+			continue
+		}
+		if suffix != "" {
+			text = strings.TrimSuffix(strings.TrimSpace(text), suffix)
+		}
+		if argument, ok := strings.CutPrefix(strings.TrimSpace(text), marker); ok {
+			return strings.TrimSpace(argument), true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,150 @@
+package app
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/phantompunk/kata/internal/domain"
+	"github.com/phantompunk/kata/pkg/assert"
+	"github.com/spf13/afero"
+)
+
+// fixtureProblem is the two_sum problem of a language in the /katas workspace
+func fixtureProblem(lang domain.Language) *domain.Problem {
+	directory := domain.Path("/katas").Join(lang.Slug(), "two_sum")
+	return &domain.Problem{
+		Language:      lang,
+		DirectoryPath: directory,
+		FileSet:       domain.NewProblemFileSet("two_sum", lang, directory),
+	}
+}
+
+// snippetFrom writes a solution and helpers into a memory workspace and
+// extracts the solution's snippet
+func snippetFrom(t *testing.T, lang domain.Language, solution string, helpers map[string]string) (*Snippet, error) {
+	t.Helper()
+	problem := fixtureProblem(lang)
+
+	fs := afero.NewMemMapFs()
+	assert.NilError(t, afero.WriteFile(fs, problem.SolutionPath(), []byte(solution), 0644))
+	for name, content := range helpers {
+		assert.NilError(t, afero.WriteFile(fs, problem.SharedPath().Join(name).String(), []byte(content), 0644))
+	}
+	return Extractor{fs: fs}.Extract(problem)
+}
+
+// extractFrom is snippetFrom returning only the code
+func extractFrom(t *testing.T, lang domain.Language, solution string, helpers map[string]string) (string, error) {
+	t.Helper()
+	snippet, err := snippetFrom(t, lang, solution, helpers)
+	if err != nil {
+		return "", err
+	}
+	return snippet.Code(), nil
+}
+
+// registerLanguage adds a language to the global registry for one test
+func registerLanguage(t *testing.T, spec domain.LanguageSpec) domain.Language {
+	t.Helper()
+	assert.NilError(t, domain.Languages.Register(spec))
+	t.Cleanup(func() { domain.Languages.Unregister(spec.Name) })
+	return domain.NewProgrammingLanguage(spec.Name)
+}
+
+func TestExtractSnippet(t *testing.T) {
+	sql := registerLanguage(t, domain.LanguageSpec{Name: "test-sql", Extension: ".sql", Comment: "--"})
+	block := registerLanguage(t, domain.LanguageSpec{Name: "test-block", Extension: ".b", Comment: "/* */"})
+	python := domain.NewProgrammingLanguage("python")
+
+	tests := []struct {
+		name    string
+		lang    domain.Language
+		content string
+		want    string
+	}{
+		{"Hash comment", python, "import math\n# ::KATA START::\nclass Solution:\n    pass\n# ::KATA END::\n", "class Solution:\n    pass"},
+		{"Legacy markers", python, "// ::KATA START::\nclass Solution:\n    pass\n// ::KATA END::\n", "class Solution:\n    pass"},
+		{"Indented markers", domain.NewProgrammingLanguage("go"), "package kata\n\n  // ::KATA START::\nfunc f() {}\n  // ::KATA END::\n", "func f() {}"},
+		{"Dash comment", sql, "-- ::KATA START::\nSELECT 1;\n-- ::KATA END::\n", "SELECT 1;"},
+		{"Block comment", block, "/* ::KATA START:: */\nint x;\n/*::KATA END::*/\n", "int x;"},
+		{"Missing end marker", python, "# ::KATA START::\nx = 1\n", "x = 1"},
+		{"Multiple regions", python, "# ::KATA START::\nx = 1\n# ::KATA END::\nprint(x)\n# ::KATA START::\ny = 2\n# ::KATA END::\n", "x = 1\n\ny = 2"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := extractFrom(t, tt.lang, tt.content, nil)
+			assert.NilError(t, err)
+			assert.Equal(t, got, tt.want)
+		})
+	}
+
+	t.Run("No markers", func(t *testing.T) {
+		_, err := extractFrom(t, python, "class Solution:\n    pass\n", nil)
+		assert.True(t, errors.Is(err, ErrNoSnippetMarkers))
+	})
+
+	t.Run("Empty region", func(t *testing.T) {
+		_, err := extractFrom(t, python, "class Solution:\n    pass\n# ::KATA START::\n\n# ::KATA END::\n", nil)
+		assert.True(t, errors.Is(err, ErrEmptySnippet))
+	})
+}
+
+func TestExtractSnippetShared(t *testing.T) {
+	python := domain.NewProgrammingLanguage("python")
+	helpers := map[string]string{
+		"union_find.py": "# ::KATA SHARED:: rank\nclass UnionFind:\n    pass\n",
+		"rank.py":       "import sys\n# ::KATA START::\ndef rank(): pass\n# ::KATA END::\n",
+	}
+
+	t.Run("Included in order, once", func(t *testing.T) {
+		solution := "# ::KATA SHARED:: union_find\n# ::KATA START::\n# ::KATA SHARED:: rank.py\nclass Solution:\n    pass\n# ::KATA END::\n"
+		got, err := extractFrom(t, python, solution, helpers)
+		assert.NilError(t, err)
+		assert.Equal(t, got, "def rank(): pass\n\nclass UnionFind:\n    pass\n\nclass Solution:\n    pass")
+	})
+
+	t.Run("Included inside a region", func(t *testing.T) {
+		solution := "# ::KATA START::\nimport sys\n# ::KATA SHARED:: rank\nclass Solution: pass\n# ::KATA END::\n"
+		got, err := extractFrom(t, python, solution, helpers)
+		assert.NilError(t, err)
+		assert.Equal(t, got, "import sys\ndef rank(): pass\nclass Solution: pass")
+	})
+
+	t.Run("Paths outside the shared directory", func(t *testing.T) {
+		outside := map[string]string{"../secret.py": "token = 1\n"}
+		for _, name := range []string{"../secret", "../../two_sum/two_sum.py", "nested/rank", "..", `..\secret`} {
+			solution := "# ::KATA SHARED:: " + name + "\n# ::KATA START::\nclass Solution: pass\n# ::KATA END::\n"
+			_, err := extractFrom(t, python, solution, outside)
+			assert.True(t, errors.Is(err, ErrInvalidSharedName))
+		}
+	})
+
+	t.Run("Missing helper", func(t *testing.T) {
+		solution := "# ::KATA SHARED:: trie\n# ::KATA START::\nclass Solution: pass\n# ::KATA END::\n"
+		_, err := extractFrom(t, python, solution, helpers)
+		assert.True(t, errors.Is(err, ErrSharedNotFound))
+	})
+}
+
+func TestSnippetLocation(t *testing.T) {
+	python := domain.NewProgrammingLanguage("python")
+	solution := "import math\n# ::KATA START::\n  x = 1\n# ::KATA END::\n\n# ::KATA START::\n\ny = 2\n"
+
+	snippet, err := snippetFrom(t, python, solution, nil)
+	assert.NilError(t, err)
+	assert.Equal(t, snippet.Code(), "x = 1\n\ny = 2")
+
+	path, line, column := snippet.Location(3, 1)
+	assert.Equal(t, path, fixtureProblem(python).SolutionPath())
+	assert.Equal(t, line, 8)
+	assert.Equal(t, column, 1)
+
+	// The indent trimmed from a region's first line still counts
+	_, line, column = snippet.Location(1, 3)
+	assert.Equal(t, line, 3)
+	assert.Equal(t, column, 5)
+
+	_, line, _ = snippet.Location(2, 1)
+	assert.Equal(t, line, 3)
+}
@@ -0,0 +1,92 @@
+package app
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/phantompunk/kata/internal/diff"
+	"github.com/phantompunk/kata/internal/domain"
+	"github.com/phantompunk/kata/internal/repository"
+)
+
+// History lists a problem's attempts in the problem's language, oldest first
+func (s *QuestionService) History(ctx context.Context, problem *domain.Problem) ([]domain.Attempt, error) {
+	rows, err := s.repo.ListAttempts(ctx, repository.ListAttemptsParams{
+		QuestionID: int64(problem.GetID()),
+		LangSlug:   problem.Language.Slug(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list attempts: %w", err)
+	}
+
+	if len(rows) == 0 {
+		return nil, ErrNoAttempts
+	}
+
+	attempts := make([]domain.Attempt, len(rows))
+	for i, row := range rows {
+		attempts[i] = row.ToAttempt(i + 1)
+	}
+	return attempts, nil
+}
+
+// LastAttempt returns the most recent test run or submission, retry
+// snapshots are skipped since they carry no verdict
+func (s *QuestionService) LastAttempt(ctx context.Context, problem *domain.Problem) (domain.Attempt, error) {
+	attempts, err := s.History(ctx, problem)
+	if err != nil {
+		return domain.Attempt{}, err
+	}
+
+	for i := len(attempts) - 1; i >= 0; i-- {
+		if attempts[i].Kind != domain.AttemptRetry {
+			return attempts[i], nil
+		}
+	}
+	return domain.Attempt{}, ErrNoAttempts
+}
+
+// Attempt returns a numbered attempt along with the code it ran
+func (s *QuestionService) Attempt(ctx context.Context, problem *domain.Problem, number int) (domain.Attempt, error) {
+	attempts, err := s.History(ctx, problem)
+	if err != nil {
+		return domain.Attempt{}, err
+	}
+
+	if number < 1 || number > len(attempts) {
+		return domain.Attempt{}, fmt.Errorf("%w: %d", ErrAttemptNotFound, number)
+	}
+
+	attempt := attempts[number-1]
+	if !attempt.HasCode() {
+		return domain.Attempt{}, fmt.Errorf("%w: %d", ErrNoSnapshot, number)
+	}
+	return attempt, nil
+}
+
+// DiffAttempts returns a unified diff between two attempts. A zero to
+// compares against the current solution file instead.
+func (s *QuestionService) DiffAttempts(ctx context.Context, problem *domain.Problem, from, to int) (string, error) {
+	older, err := s.Attempt(ctx, problem, from)
+	if err != nil {
+		return "", err
+	}
+
+	if to == 0 {
+		current, err := s.extractor.ExtractSnippet(problem)
+		if err != nil {
+			return "", fmt.Errorf("failed to read solution: %w", err)
+		}
+		return diff.Unified(attemptLabel(older), "current", older.Code, current), nil
+	}
+
+	newer, err := s.Attempt(ctx, problem, to)
+	if err != nil {
+		return "", err
+	}
+	return diff.Unified(attemptLabel(older), attemptLabel(newer), older.Code, newer.Code), nil
+}
+
+func attemptLabel(attempt domain.Attempt) string {
+	return fmt.Sprintf("attempt %d (%s)", attempt.Number, attempt.CreatedAt.Format("2006-01-02 15:04"))
+}
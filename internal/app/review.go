@@ -0,0 +1,73 @@
+package app
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/phantompunk/kata/internal/domain"
+	"github.com/phantompunk/kata/internal/repository"
+	"github.com/phantompunk/kata/internal/srs"
+)
+
+// ReviewProblem reschedules a problem in its language after a review. It
+// reviews cards that are not due too, so failures always start over; callers
+// rating accepted solutions check IsDue first.
+func (s *QuestionService) ReviewProblem(ctx context.Context, problem *domain.Problem, grade srs.Grade) (srs.Card, error) {
+	card, err := s.ReviewCard(ctx, problem)
+	if err != nil {
+		return srs.Card{}, err
+	}
+
+	card = card.Review(grade, time.Now())
+	err = s.repo.SaveReview(ctx, repository.ToSaveReviewParams(int64(problem.GetID()), problem.Language.Slug(), card))
+	if err != nil {
+		return srs.Card{}, fmt.Errorf("failed to save review: %w", err)
+	}
+	return card, nil
+}
+
+// ReviewCard returns the review state of a problem in its language. Problems
+// never reviewed get a fresh card, which is due right away.
+func (s *QuestionService) ReviewCard(ctx context.Context, problem *domain.Problem) (srs.Card, error) {
+	review, err := s.repo.GetReview(ctx, repository.GetReviewParams{
+		QuestionID: int64(problem.GetID()),
+		LangSlug:   problem.Language.Slug(),
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return srs.NewCard(), nil
+	}
+	if err != nil {
+		return srs.Card{}, fmt.Errorf("failed to get review: %w", err)
+	}
+	return review.ToCard(), nil
+}
+
+// DueReviews lists problems due for review today, most overdue first
+func (s *QuestionService) DueReviews(ctx context.Context) ([]domain.Review, error) {
+	rows, err := s.repo.ListDueReviews(ctx, time.Now().Format(time.DateOnly))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list due reviews: %w", err)
+	}
+
+	reviews := make([]domain.Review, len(rows))
+	for i, row := range rows {
+		reviews[i] = row.ToReview()
+	}
+	return reviews, nil
+}
+
+// NextReviewDate returns the earliest scheduled review, or ErrNoReviews
+// when nothing has been solved yet
+func (s *QuestionService) NextReviewDate(ctx context.Context) (time.Time, error) {
+	due, err := s.repo.GetNextReviewDate(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return time.Time{}, ErrNoReviews
+		}
+		return time.Time{}, fmt.Errorf("failed to get next review: %w", err)
+	}
+	return time.ParseInLocation(time.DateOnly, due, time.Local)
+}
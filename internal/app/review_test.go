@@ -0,0 +1,47 @@
+package app
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/phantompunk/kata/internal/db"
+	"github.com/phantompunk/kata/internal/domain"
+	"github.com/phantompunk/kata/internal/leetcode"
+	"github.com/phantompunk/kata/internal/repository"
+	"github.com/phantompunk/kata/internal/srs"
+	"github.com/phantompunk/kata/pkg/assert"
+)
+
+// newMemoryService returns a service backed by a migrated in-memory database
+func newMemoryService(t *testing.T) *QuestionService {
+	t.Helper()
+	conn, err := sql.Open("sqlite3", ":memory:")
+	assert.NilError(t, err)
+	// Every connection to :memory: is a new database
+	conn.SetMaxOpenConns(1)
+	t.Cleanup(func() { conn.Close() })
+
+	assert.NilError(t, db.Migrate(conn))
+	return &QuestionService{repo: repository.New(conn)}
+}
+
+func TestFailedSubmissionResetsReview(t *testing.T) {
+	ctx := context.Background()
+	s := newMemoryService(t)
+	problem := &domain.Problem{ID: "1", Slug: "two-sum", Language: domain.NewProgrammingLanguage("go")}
+
+	now := time.Now()
+	scheduled := srs.Card{Ease: srs.DefaultEase, Interval: 20, Repetitions: 3, Due: now.AddDate(0, 0, 20), LastReview: now}
+	assert.NilError(t, s.repo.SaveReview(ctx, repository.ToSaveReviewParams(1, problem.Language.Slug(), scheduled)))
+
+	result := &leetcode.SubmissionResult{State: "SUCCESS", Status: leetcode.StatusWrongAnswer, IsSolution: true}
+	_, err := s.recordResult(ctx, problem, "42", result)
+	assert.NilError(t, err)
+
+	card, err := s.ReviewCard(ctx, problem)
+	assert.NilError(t, err)
+	assert.Equal(t, card.Repetitions, 0)
+	assert.Equal(t, card.Interval, 1)
+}
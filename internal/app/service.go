@@ -1,26 +1,35 @@
 package app
 
 import (
-	"bufio"
 	"context"
 	"database/sql"
 	"errors"
 	"fmt"
-	"strings"
 	"time"
 
 	"github.com/phantompunk/kata/internal/domain"
+	"github.com/phantompunk/kata/internal/judge"
 	"github.com/phantompunk/kata/internal/leetcode"
 	"github.com/phantompunk/kata/internal/render"
 	"github.com/phantompunk/kata/internal/repository"
+	"github.com/phantompunk/kata/internal/runner"
+	"github.com/phantompunk/kata/internal/srs"
+	"github.com/phantompunk/kata/internal/validator"
 	"github.com/spf13/afero"
 )
 
+const (
+	initialPollInterval = 500 * time.Millisecond
+	maxPollInterval     = 4 * time.Second
+)
+
 type QuestionService struct {
 	repo      *repository.Queries
 	client    leetcode.Client
 	renderer  render.Renderer
+	runner    runner.Runner
 	extractor *Extractor
+	fs        afero.Fs
 }
 
 func NewQuestionService(repo *repository.Queries, client leetcode.Client, renderer render.Renderer) *QuestionService {
@@ -28,7 +37,9 @@ func NewQuestionService(repo *repository.Queries, client leetcode.Client, render
 		repo:      repo,
 		client:    client,
 		renderer:  renderer,
+		runner:    runner.New(),
 		extractor: NewExtractor(),
+		fs:        afero.NewOsFs(),
 	}
 }
 
@@ -42,18 +53,12 @@ func (s *QuestionService) GetQuestion(ctx context.Context, opts AppOptions) (*do
 		return toProblem(question, opts) 
 	}
 
-	apiQuestion, err := s.client.FetchQuestion(ctx, opts.Problem)
-	if err != nil {
-		if errors.Is(err, leetcode.ErrQuestionNotFound) {
-			return nil, ErrQuestionNotFound
-		}
-		return nil, fmt.Errorf("failed to fetch question %q: %w", opts.Problem, err)
+	apiQuestion, err := s.fetchQuestion(ctx, opts)
+	if errors.Is(err, ErrPaidOnlyProblem) {
+		return &domain.Problem{Title: apiQuestion.Title, Slug: apiQuestion.TitleSlug}, err
 	}
-
-	// Check if free user is trying to access paid-only problem
-	if apiQuestion.PaidOnly && !opts.IsPremium {
-		
-		return &domain.Problem{Title: apiQuestion.Title, Slug: apiQuestion.TitleSlug}, ErrPaidOnlyProblem
+	if err != nil {
+		return nil, err
 	}
 
 	// TODO: consider fire and forget
@@ -65,36 +70,104 @@ func (s *QuestionService) GetQuestion(ctx context.Context, opts AppOptions) (*do
 	return toProblem(createdQuestion, opts)
 }
 
+// RefreshQuestion downloads a stored problem again and replaces its statement,
+// snippets and examples, unlike GetQuestion which keeps the stored content
+func (s *QuestionService) RefreshQuestion(ctx context.Context, opts AppOptions) (*domain.Problem, error) {
+	apiQuestion, err := s.fetchQuestion(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	refreshed, err := s.repo.Refresh(ctx, repository.ToRepoRefreshParams(apiQuestion))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrQuestionNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to refresh question in repository: %w", err)
+	}
+
+	return toProblem(refreshed, opts)
+}
+
+// fetchQuestion downloads a problem from LeetCode, free users get
+// ErrPaidOnlyProblem along with the question for paid-only problems
+func (s *QuestionService) fetchQuestion(ctx context.Context, opts AppOptions) (*leetcode.Question, error) {
+	apiQuestion, err := s.client.FetchQuestion(ctx, opts.Problem)
+	if err != nil {
+		if errors.Is(err, leetcode.ErrQuestionNotFound) {
+			return nil, ErrQuestionNotFound
+		}
+		return nil, fmt.Errorf("failed to fetch question %q: %w", opts.Problem, err)
+	}
+
+	if apiQuestion.PaidOnly && !opts.IsPremium {
+		return apiQuestion, ErrPaidOnlyProblem
+	}
+	return apiQuestion, nil
+}
+
 func (s *QuestionService) Stub(ctx context.Context, problem *domain.Problem, opts AppOptions) (*render.RenderResult, error) {
+	if opts.Retry && problem.SolutionExists() {
+		if err := s.snapshotSolution(ctx, problem); err != nil {
+			return nil, err
+		}
+	}
 	return s.renderer.RenderProblem(ctx, problem, opts.Force, opts.Retry)
 }
 
-func (s *QuestionService) GetRandomQuestion(ctx context.Context, opts AppOptions) (*domain.Problem, error) {
-	question, err := s.repo.GetRandomWeighted(ctx)
+// GetQuizQuestions picks the problems most in need of practice in the quiz
+// language, the status filters only count submissions in that language
+func (s *QuestionService) GetQuizQuestions(ctx context.Context, opts AppOptions, filter repository.QuizFilter) ([]*domain.Problem, error) {
+	filter.Language = domain.NewProgrammingLanguage(opts.Language).Slug()
+	if filter.Limit < 1 {
+		filter.Limit = 1
+	}
+
+	questions, err := s.repo.ListQuiz(ctx, filter)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return nil, ErrNoQuestions
-		}
-		return nil, fmt.Errorf("failed to get random question: %w", err)
+		return nil, fmt.Errorf("failed to get quiz questions: %w", err)
 	}
 
-	return question.ToProblem(opts.Workspace, opts.Language), nil
+	if len(questions) == 0 {
+		return nil, ErrNoQuestions
+	}
+
+	problems := make([]*domain.Problem, 0, len(questions))
+	for _, question := range questions {
+		problem, err := question.ToProblem(opts.Workspace, opts.Language)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read quiz question %s: %w", question.TitleSlug, err)
+		}
+		problems = append(problems, problem)
+	}
+	return problems, nil
 }
 
 func (s *QuestionService) SubmitTest(ctx context.Context, problem *domain.Problem, opts AppOptions) (string, error) {
-	snippet, err := s.extractor.ExtractSnippet(problem.SolutionPath())
+	snippet, err := s.prepareSnippet(problem)
 	if err != nil {
 		return "", err
 	}
+	if err := s.loadCustomCases(ctx, problem); err != nil {
+		return "", err
+	}
+
+	if opts.OnlyCustom {
+		if len(problem.CustomCases) == 0 {
+			return "", ErrNoCustomTestcases
+		}
+		problem.Testcases = nil
+	}
+
 	submissionId, err := s.client.SubmitTest(ctx, problem, snippet)
 	if err != nil {
 		return "", err
 	}
-	return submissionId, err
+	return submissionId, s.trackSubmission(ctx, problem, submissionId, snippet)
 }
 
 func (s *QuestionService) SubmitSolution(ctx context.Context, problem *domain.Problem, opts AppOptions) (string, error) {
-	snippet, err := s.extractor.ExtractSnippet(problem.SolutionPath())
+	snippet, err := s.prepareSnippet(problem)
 	if err != nil {
 		return "", err
 	}
@@ -103,50 +176,163 @@ func (s *QuestionService) SubmitSolution(ctx context.Context, problem *domain.Pr
 	if err != nil {
 		return "", err
 	}
-	return submissionId, err
+	return submissionId, s.trackSubmission(ctx, problem, submissionId, snippet)
+}
+
+// prepareSnippet extracts the code to submit and validates it locally, so a
+// syntax error is reported at its line in the solution without costing an attempt
+func (s *QuestionService) prepareSnippet(problem *domain.Problem) (string, error) {
+	snippet, err := s.extractor.Extract(problem)
+	if err != nil {
+		return "", err
+	}
+
+	code, err := validator.For(problem.Language).Validate(snippet.Code())
+	var syntaxErr *validator.SyntaxError
+	if errors.As(err, &syntaxErr) {
+		path, line, column := snippet.Location(syntaxErr.Line, syntaxErr.Column)
+		return "", fmt.Errorf("%w: %s:%d:%d: %s", validator.ErrInvalidSnippet, path, line, column, syntaxErr.Message)
+	}
+	return code, err
 }
 
-func (s *QuestionService) WaitForResult(ctx context.Context, problem *domain.Problem, submissionId string, maxWaitTime time.Duration) (*leetcode.SubmissionResult, error) {
-	startTime := time.Now()
-	pollInterval := 1 * time.Second
+// RunLocalTests runs the generated tests, refreshing the harness first so it
+// includes the latest custom test cases
+func (s *QuestionService) RunLocalTests(ctx context.Context, problem *domain.Problem) (*runner.Result, error) {
+	if err := s.loadCustomCases(ctx, problem); err != nil {
+		return nil, err
+	}
+
+	if len(problem.CustomCases) > 0 {
+		// Problems the harness cannot judge only run the generated tests
+		if err := s.renderer.RenderHarness(ctx, problem); err != nil && !judge.IsUnsupported(err) {
+			return nil, fmt.Errorf("failed to refresh harness: %w", err)
+		}
+	}
+	return s.runner.Run(ctx, problem)
+}
 
-	for time.Since(startTime) < maxWaitTime {
-		result, err := s.client.CheckSubmissionResult(ctx, submissionId)
+// WaitForResult polls for a submission verdict with a growing interval until
+// the timeout elapses. Unfinished submissions stay pending so they can be checked later.
+func (s *QuestionService) WaitForResult(ctx context.Context, problem *domain.Problem, submissionId string, timeout time.Duration) (*leetcode.SubmissionResult, error) {
+	pollCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	pollInterval := initialPollInterval
+	for {
+		result, err := s.client.CheckSubmissionResult(pollCtx, submissionId)
 		if err != nil {
+			if pollCtx.Err() != nil {
+				return nil, stopWaiting(ctx, submissionId)
+			}
 			return nil, err
 		}
 
-		now := time.Now().Format(time.RFC3339)
-		questionID := int64(problem.GetID())
-		langSlug := problem.Language.Slug()
-
 		switch result.State {
-		case "SUCCESS":
-			// Ensure submission record exists, then increment times_solved
-			s.repo.Submit(ctx, repository.SubmitParams{QuestionID: questionID, LangSlug: langSlug, Solved: 1, LastAttempted: now})
-			s.repo.IncrementTimesSolved(ctx, repository.IncrementTimesSolvedParams{
-				LastAttempted: now,
-				QuestionID:    questionID,
-				LangSlug:      langSlug,
-			})
-			return result, nil
+		case "SUCCESS", "FAILED":
+			return s.recordResult(ctx, problem, submissionId, result)
 		case "PENDING", "STARTED", "EVALUATION":
-			time.Sleep(pollInterval)
-		case "FAILED":
-			// Ensure submission record exists, then increment failed_attempts
-			s.repo.Submit(ctx, repository.SubmitParams{QuestionID: questionID, LangSlug: langSlug, Solved: 0, LastAttempted: now})
-			s.repo.IncrementFailedAttempts(ctx, repository.IncrementFailedAttemptsParams{
-				LastAttempted: now,
-				QuestionID:    questionID,
-				LangSlug:      langSlug,
-			})
-			return result, ErrSolutionFailed
 		default:
 			return nil, fmt.Errorf("unexpected submission state: %s", result.State)
 		}
+
+		if err := leetcode.SleepContext(pollCtx, pollInterval); err != nil {
+			return nil, stopWaiting(ctx, submissionId)
+		}
+		pollInterval = min(pollInterval*2, maxPollInterval)
 	}
+}
 
-	return nil, fmt.Errorf("submission timed out after %v", maxWaitTime)
+// CheckResult resumes polling for a submission that was still being judged
+// when an earlier test or submit gave up waiting
+func (s *QuestionService) CheckResult(ctx context.Context, submissionId string, opts AppOptions, timeout time.Duration) (*domain.Problem, *leetcode.SubmissionResult, error) {
+	pending, err := s.repo.GetPendingSubmission(ctx, submissionId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil, ErrUnknownSubmission
+		}
+		return nil, nil, fmt.Errorf("failed to get pending submission: %w", err)
+	}
+
+	question, err := s.repo.GetByID(ctx, pending.QuestionID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get question %d: %w", pending.QuestionID, err)
+	}
+
+	problem, err := question.ToProblem(opts.Workspace, pending.LangSlug)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := s.loadCustomCases(ctx, problem); err != nil {
+		return nil, nil, err
+	}
+
+	result, err := s.WaitForResult(ctx, problem, submissionId, timeout)
+	return problem, result, err
+}
+
+// recordResult adds a finished test run or submission to the attempt history.
+// Test runs only check examples, so they never mark a problem as solved.
+// Rejected solutions are rescheduled for review right away, accepted ones
+// wait for the user's rating through ReviewProblem.
+func (s *QuestionService) recordResult(ctx context.Context, problem *domain.Problem, submissionId string, result *leetcode.SubmissionResult) (*leetcode.SubmissionResult, error) {
+	pending, err := s.repo.GetPendingSubmission(ctx, submissionId)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("failed to get pending submission: %w", err)
+	}
+	s.repo.DeletePendingSubmission(ctx, submissionId)
+
+	// Runs the judge failed on still count as attempts
+	if err := s.recordAttempt(ctx, problem, submissionId, pending.CodeHash, result); err != nil {
+		return nil, err
+	}
+
+	if result.State == "FAILED" {
+		return result, ErrSolutionFailed
+	}
+
+	now := time.Now().Format(time.RFC3339)
+	questionID := int64(problem.GetID())
+	langSlug := problem.Language.Slug()
+
+	if !result.IsSolution {
+		// Runs count as attempted like before, without touching whether it is solved
+		err := s.repo.RecordTestRun(ctx, repository.RecordTestRunParams{QuestionID: questionID, LangSlug: langSlug, LastAttempted: now})
+		if err != nil {
+			return nil, fmt.Errorf("failed to record test run: %w", err)
+		}
+		return result.WithInputs(problem.AllTestcases()), nil
+	}
+
+	if !result.IsAccepted() {
+		// Only wrong answers and timeouts have a case worth running again
+		saveable := result.Status == leetcode.StatusWrongAnswer || result.Status == leetcode.StatusTimeLimitExceeded
+		if saveable && result.LastCase != nil && result.LastCase.Input != "" {
+			if result.SavedCase, err = s.saveFailingCase(ctx, problem, result.LastCase); err != nil {
+				return nil, err
+			}
+		}
+
+		s.repo.Submit(ctx, repository.SubmitParams{QuestionID: questionID, LangSlug: langSlug, Solved: 0, LastAttempted: now})
+		if _, err := s.ReviewProblem(ctx, problem, srs.Again); err != nil {
+			return nil, err
+		}
+		return result, nil
+	}
+
+	s.repo.Submit(ctx, repository.SubmitParams{QuestionID: questionID, LangSlug: langSlug, Solved: 1, LastAttempted: now})
+	// The verdict is kept with the error, the submission was accepted either way
+	return result, s.completeDailyChallenge(ctx, problem.Slug)
+}
+
+// stopWaiting reports why polling stopped early, the submission itself was
+// already tracked as pending when it was sent
+func stopWaiting(ctx context.Context, submissionId string) error {
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	return fmt.Errorf("%w: %s", ErrResultTimeout, submissionId)
 }
 
 func (s *QuestionService) GetBySlug(ctx context.Context, opts AppOptions) (*domain.Problem, error) {
@@ -161,8 +347,15 @@ func (s *QuestionService) GetBySlug(ctx context.Context, opts AppOptions) (*doma
 	return question.ToProblem(opts.Workspace, opts.Language)
 }
 
-func (s *QuestionService) GetAllQuestionsWithStatus(ctx context.Context, opts AppOptions) ([]domain.QuestionStat, error) {
-	stats, err := s.repo.GetAllWithStatus(ctx, opts.Tracks)
+// GetAllQuestionsWithStatus lists downloaded problems with their progress in
+// each of the tracked languages
+func (s *QuestionService) GetAllQuestionsWithStatus(ctx context.Context, opts AppOptions, filter repository.ListFilter) ([]domain.QuestionStat, error) {
+	filter.Tracks = make([]repository.Track, len(opts.Tracks))
+	for i, track := range opts.Tracks {
+		filter.Tracks[i] = repository.Track{Name: track, LangSlug: domain.NewProgrammingLanguage(track).Slug()}
+	}
+
+	stats, err := s.repo.ListWithStatus(ctx, filter)
 	if err != nil {
 		return nil, err
 	}
@@ -185,47 +378,3 @@ func (s *QuestionService) GetStats(ctx context.Context) (repository.GetStatsRow,
 func toProblem(question repository.Question, opts AppOptions) (*domain.Problem, error) {
 	return question.ToProblem(opts.Workspace, opts.Language)
 }
-
-type Extractor struct {
-	fs afero.Fs
-}
-
-func NewExtractor() *Extractor {
-	return &Extractor{fs: afero.NewOsFs()}
-}
-
-func (e Extractor) ExtractSnippet(path string) (string, error) {
-	file, err := e.fs.Open(path)
-	if err != nil {
-		return "", err
-	}
-	defer file.Close()
-
-	startMarker := fmt.Sprint("// ::KATA START::")
-	endMarker := fmt.Sprint("// ::KATA END::")
-
-	var builder strings.Builder
-	scanner := bufio.NewScanner(file)
-
-	inSnippet := false
-	for scanner.Scan() {
-		line := scanner.Text()
-
-		if strings.TrimSpace(line) == startMarker {
-			inSnippet = true
-			continue
-		}
-
-		if strings.TrimSpace(line) == endMarker {
-			break
-		}
-
-		if inSnippet {
-			builder.WriteString(line + "\n")
-		}
-	}
-	if err := scanner.Err(); err != nil {
-		return "", err
-	}
-	return strings.TrimSpace(builder.String()), nil
-}
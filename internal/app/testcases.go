@@ -0,0 +1,138 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/phantompunk/kata/internal/domain"
+	"github.com/phantompunk/kata/internal/leetcode"
+	"github.com/phantompunk/kata/internal/repository"
+	"github.com/spf13/afero"
+)
+
+// loadCustomCases merges the stored custom cases with the problem's
+// testcases file, which may have been edited by hand
+func (s *QuestionService) loadCustomCases(ctx context.Context, problem *domain.Problem) error {
+	stored, err := s.repo.ListCustomTestcases(ctx, int64(problem.GetID()))
+	if err != nil {
+		return fmt.Errorf("failed to list custom test cases: %w", err)
+	}
+
+	var cases []domain.Testcase
+	for _, c := range stored {
+		cases = append(cases, c.ToTestcase())
+	}
+
+	fromFile, err := s.readTestcasesFile(problem)
+	if err != nil {
+		return err
+	}
+
+	problem.CustomCases = domain.MergeTestcases(cases, fromFile...)
+	return nil
+}
+
+// CustomCases returns the problem's custom test cases from the database and its testcases file
+func (s *QuestionService) CustomCases(ctx context.Context, problem *domain.Problem) ([]domain.Testcase, error) {
+	if err := s.loadCustomCases(ctx, problem); err != nil {
+		return nil, err
+	}
+	return problem.CustomCases, nil
+}
+
+// AddCustomCase validates and stores a user supplied test case
+func (s *QuestionService) AddCustomCase(ctx context.Context, problem *domain.Problem, testcase domain.Testcase) error {
+	values := strings.Split(testcase.Input, "\n")
+	if len(problem.Params) > 0 && len(values) != len(problem.Params) {
+		return fmt.Errorf("%w: got %d values for %d parameters", ErrInvalidTestcase, len(values), len(problem.Params))
+	}
+	_, err := s.addCustomCase(ctx, problem, testcase, "user")
+	return err
+}
+
+// RemoveCustomCase deletes the custom test case at the given 1-based position
+func (s *QuestionService) RemoveCustomCase(ctx context.Context, problem *domain.Problem, position int) (domain.Testcase, error) {
+	cases, err := s.CustomCases(ctx, problem)
+	if err != nil {
+		return domain.Testcase{}, err
+	}
+
+	if position < 1 || position > len(cases) {
+		return domain.Testcase{}, fmt.Errorf("%w: %d", ErrTestcaseNotFound, position)
+	}
+	removed := cases[position-1]
+
+	err = s.repo.DeleteCustomTestcase(ctx, repository.DeleteCustomTestcaseParams{
+		QuestionID: int64(problem.GetID()),
+		Input:      removed.Input,
+	})
+	if err != nil {
+		return domain.Testcase{}, fmt.Errorf("failed to delete custom test case: %w", err)
+	}
+
+	fromFile, err := s.readTestcasesFile(problem)
+	if err != nil {
+		return domain.Testcase{}, err
+	}
+
+	kept := slices.DeleteFunc(fromFile, func(c domain.Testcase) bool { return c.Input == removed.Input })
+	if _, err := s.writeTestcasesFile(problem, kept); err != nil {
+		return domain.Testcase{}, err
+	}
+	return removed, nil
+}
+
+// saveFailingCase keeps the hidden case a submission failed on so later test
+// runs include it, and reports whether it was written to the testcases file
+func (s *QuestionService) saveFailingCase(ctx context.Context, problem *domain.Problem, failed *leetcode.CaseResult) (bool, error) {
+	return s.addCustomCase(ctx, problem, domain.Testcase{Input: failed.Input, Expected: failed.Expected}, "submission")
+}
+
+func (s *QuestionService) addCustomCase(ctx context.Context, problem *domain.Problem, testcase domain.Testcase, source string) (bool, error) {
+	err := s.repo.AddCustomTestcase(ctx, repository.AddCustomTestcaseParams{
+		QuestionID: int64(problem.GetID()),
+		Input:      testcase.Input,
+		Expected:   testcase.Expected,
+		Source:     source,
+		CreatedAt:  time.Now().Format(time.RFC3339),
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to save custom test case: %w", err)
+	}
+
+	cases, err := s.readTestcasesFile(problem)
+	if err != nil {
+		return false, err
+	}
+	return s.writeTestcasesFile(problem, domain.MergeTestcases(cases, testcase))
+}
+
+func (s *QuestionService) readTestcasesFile(problem *domain.Problem) ([]domain.Testcase, error) {
+	content, err := afero.ReadFile(s.fs, problem.TestcasesPath().String())
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read %s: %w", domain.TestcasesFile, err)
+	}
+	return domain.ParseTestcases(string(content)), nil
+}
+
+// writeTestcasesFile reports whether the file was written, problems submitted
+// without kata get have no directory to write into
+func (s *QuestionService) writeTestcasesFile(problem *domain.Problem, cases []domain.Testcase) (bool, error) {
+	if !problem.DirectoryPath.Exists() {
+		return false, nil
+	}
+
+	path := problem.TestcasesPath().String()
+	if err := afero.WriteFile(s.fs, path, []byte(domain.FormatTestcases(cases)), 0644); err != nil {
+		return false, fmt.Errorf("failed to write %s: %w", domain.TestcasesFile, err)
+	}
+	return true, nil
+}
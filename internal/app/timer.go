@@ -0,0 +1,54 @@
+package app
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/phantompunk/kata/internal/domain"
+	"github.com/phantompunk/kata/internal/repository"
+)
+
+// StartTimer starts timing an attempt at a problem in its language,
+// a timer that is already running starts over
+func (s *QuestionService) StartTimer(ctx context.Context, problem *domain.Problem) (time.Time, error) {
+	now := time.Now()
+	err := s.repo.StartTimer(ctx, repository.StartTimerParams{
+		QuestionID: int64(problem.GetID()),
+		LangSlug:   problem.Language.Slug(),
+		StartedAt:  now.Format(time.RFC3339),
+	})
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to start timer: %w", err)
+	}
+	return now, nil
+}
+
+// StopTimer ends the problem's running timer and returns how long the
+// attempt took, ok is false when no timer was started
+func (s *QuestionService) StopTimer(ctx context.Context, problem *domain.Problem) (elapsed time.Duration, ok bool, err error) {
+	params := repository.GetTimerParams{
+		QuestionID: int64(problem.GetID()),
+		LangSlug:   problem.Language.Slug(),
+	}
+
+	timer, err := s.repo.GetTimer(ctx, params)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, false, nil
+		}
+		return 0, false, fmt.Errorf("failed to get timer: %w", err)
+	}
+
+	if err := s.repo.DeleteTimer(ctx, repository.DeleteTimerParams(params)); err != nil {
+		return 0, false, fmt.Errorf("failed to stop timer: %w", err)
+	}
+
+	started, err := time.Parse(time.RFC3339, timer.StartedAt)
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to read timer: %w", err)
+	}
+	return time.Since(started), true, nil
+}
@@ -6,11 +6,11 @@ import (
 	"os"
 	"os/user"
 	"path/filepath"
-	"strings"
 	"text/template"
 
 	"github.com/adrg/xdg"
 	"github.com/go-yaml/yaml"
+	"github.com/phantompunk/kata/internal/domain"
 	"github.com/phantompunk/kata/pkg/editor"
 )
 
@@ -62,9 +62,18 @@ func (s *ConfigService) EnsureConfig() (*Config, error) {
 		return nil, err
 	}
 
+	registerLanguages(cfg.Languages)
 	return cfg, nil
 }
 
+// registerLanguages adds the config's languages to the registry kata uses
+func registerLanguages(specs []domain.LanguageSpec) {
+	for _, spec := range specs {
+		// Invalid languages were already reported as warnings by validation
+		_ = domain.Languages.Register(spec)
+	}
+}
+
 func (s *ConfigService) EditConfig() error {
 	cfg, err := s.EnsureConfig()
 	if err != nil {
@@ -156,13 +165,17 @@ func (s *ConfigService) createDefault() *Config {
 	language, _ := NewLanguage("python3")
 
 	return &Config{
-		workspace:    workspace,
-		language:     language,
-		OpenInEditor: false,
-		Verbose:      false,
-		Session:      Session{},
-		Username:     "",
-		Tracks:       []string{language.String()},
+		workspace:     workspace,
+		language:      language,
+		OpenInEditor:  false,
+		Verbose:       false,
+		Session:       Session{},
+		Username:      "",
+		Tracks:        []string{language.String()},
+		MaxRetries:    DefaultMaxRetries,
+		RateLimit:     DefaultRateLimit,
+		ResultTimeout: DefaultResultTimeout,
+		ListHeight:    DefaultListHeight,
 	}
 }
 
@@ -256,11 +269,20 @@ func (v *ConfigValidator) Validate(c *Config) error {
 		return errors.New("workspace is not set")
 	}
 
+	// User languages are checked in a copy of the registry, so validating a
+	// config that is then rejected leaves the languages kata uses alone
+	registry := domain.Languages.Clone()
+	for _, spec := range c.Languages {
+		if err := registry.Register(spec); err != nil {
+			return fmt.Errorf("invalid language: %w", err)
+		}
+	}
+
 	if c.language == "" {
 		return errors.New("language is not set")
 	}
 
-	if !v.IsSupportedLanguage(c.LanguageName()) {
+	if _, ok := registry.Lookup(c.LanguageName()); !ok {
 		return fmt.Errorf("language %q is not supported: %w", c.LanguageName(), ErrUnsupportedLanguage)
 	}
 
@@ -277,13 +299,22 @@ func (v *ConfigValidator) ValidateWithFallback(c *Config) error {
 		return errors.New("workspace is not set")
 	}
 
+	// User languages are checked first so the language can use them, in a copy
+	// of the registry until EnsureConfig accepts the config
+	registry := domain.Languages.Clone()
+	for _, spec := range c.Languages {
+		if err := registry.Register(spec); err != nil {
+			v.warnings = append(v.warnings, fmt.Sprintf("ignoring language: %v", err))
+		}
+	}
+
 	if c.language == "" {
 		result := NewLanguageWithFallback("")
 		c.language = result.Language
 		v.warnings = append(v.warnings, result.Warning)
 	}
 
-	if !v.IsSupportedLanguage(c.LanguageName()) {
+	if _, ok := registry.Lookup(c.LanguageName()); !ok {
 		result := NewLanguageWithFallback(c.LanguageName())
 		v.warnings = append(v.warnings, result.Warning)
 		c.language = result.Language
@@ -295,6 +326,24 @@ func (v *ConfigValidator) ValidateWithFallback(c *Config) error {
 		c.Tracks = append(c.Tracks, DefaultLanguage)
 	}
 
+	if c.MaxRetries < 0 {
+		warning := fmt.Sprintf("maxRetries cannot be negative, using default: %d", DefaultMaxRetries)
+		v.warnings = append(v.warnings, warning)
+		c.MaxRetries = DefaultMaxRetries
+	}
+
+	if c.ResultTimeout <= 0 {
+		warning := fmt.Sprintf("resultTimeout must be positive, using default: %d", DefaultResultTimeout)
+		v.warnings = append(v.warnings, warning)
+		c.ResultTimeout = DefaultResultTimeout
+	}
+
+	if c.ListHeight < domain.MinListHeight {
+		warning := fmt.Sprintf("listHeight must be at least %d, using default: %d", domain.MinListHeight, DefaultListHeight)
+		v.warnings = append(v.warnings, warning)
+		c.ListHeight = DefaultListHeight
+	}
+
 	session := c.Session
 	if (session.SessionToken == "") != (session.CsrfToken == "") {
 		return errors.New("both sessionToken and csrfToken must be set or unset")
@@ -308,47 +357,24 @@ func (v *ConfigValidator) IsSupportedLanguage(lang string) bool {
 	return normalized != ""
 }
 
-var supportedLanguages = map[string]string{
-	"go":         "golang",
-	"golang":     "golang",
-	"javascript": "javascript",
-	"js":         "javascript",
-	"python":     "python",
-	"python3":    "python",
-	"py":         "python",
-	"typescript": "typescript",
-	"ts":         "typescript",
-	"rust":       "rust",
-	"c":          "c",
-	"csharp":     "csharp",
-	"c#":         "csharp",
-	"cpp":        "cpp",
-	"c++":        "cpp",
-	"java":       "java",
-	"ruby":       "ruby",
-	"swift":      "swift",
-	"kotlin":     "kotlin",
-	"scala":      "scala",
-	"php":        "php",
-}
-
+// normalizeLanguage returns the canonical name of a language or alias,
+// or an empty string when the language is not registered
 func normalizeLanguage(lang string) string {
-	normalized, ok := supportedLanguages[strings.ToLower(strings.TrimSpace(lang))]
+	spec, ok := domain.Languages.Lookup(lang)
 	if !ok {
 		return ""
 	}
-	return normalized
+	return spec.Name
 }
 
-func GetSupportedLanguages() map[string]string {
-	return supportedLanguages
+// SupportedLanguages lists the canonical names of all registered languages
+func SupportedLanguages() []string {
+	return domain.Languages.Names()
 }
 
 func NormalizeLanguage(language string) (string, error) {
-	normalized := strings.ToLower(strings.TrimSpace(language))
-
-	canonical, ok := supportedLanguages[normalized]
-	if !ok {
+	canonical := normalizeLanguage(language)
+	if canonical == "" {
 		return "", fmt.Errorf("language %q is not supported: %w", language, ErrUnsupportedLanguage)
 	}
 	return canonical, nil
@@ -0,0 +1,48 @@
+package config
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/phantompunk/kata/internal/domain"
+	"github.com/phantompunk/kata/pkg/assert"
+)
+
+func TestValidateLeavesLanguagesAlone(t *testing.T) {
+	workspace, err := NewWorkspace("/katas")
+	assert.NilError(t, err)
+	cfg := &Config{
+		workspace: workspace,
+		language:  Language("test-elixir"),
+		Languages: []domain.LanguageSpec{{Name: "test-elixir", Aliases: []string{"py"}, Extension: ".ex"}},
+	}
+
+	assert.NilError(t, NewConfigValidator().Validate(cfg))
+	assert.NilError(t, NewConfigValidator().ValidateWithFallback(cfg))
+	assert.Equal(t, cfg.LanguageName(), "test-elixir")
+
+	_, ok := domain.Languages.Lookup("test-elixir")
+	assert.False(t, ok)
+	spec, _ := domain.Languages.Lookup("py")
+	assert.Equal(t, spec.Name, "python")
+}
+
+func TestEnsureConfigRegistersLanguages(t *testing.T) {
+	workspace, err := NewWorkspace("/katas")
+	assert.NilError(t, err)
+	repo := ConfigRepository{path: filepath.Join(t.TempDir(), "kata.yml")}
+	assert.NilError(t, repo.Save(&Config{
+		workspace: workspace,
+		language:  Language("test-elixir"),
+		Tracks:    []string{"test-elixir"},
+		Languages: []domain.LanguageSpec{{Name: "test-elixir", Extension: ".ex"}},
+	}))
+	t.Cleanup(func() { domain.Languages.Unregister("test-elixir") })
+
+	cfg, err := NewConfigService(repo, *NewConfigValidator()).EnsureConfig()
+	assert.NilError(t, err)
+	assert.Equal(t, cfg.LanguageName(), "test-elixir")
+
+	_, ok := domain.Languages.Lookup("test-elixir")
+	assert.True(t, ok)
+}
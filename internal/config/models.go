@@ -5,50 +5,75 @@ import (
 	"fmt"
 	"path/filepath"
 	"slices"
+	"time"
+
+	"github.com/phantompunk/kata/internal/domain"
 )
 
 var configTemplate string
 
 type Config struct {
-	workspace    Workspace `yaml:"workspace"`
-	language     Language  `yaml:"language"`
-	OpenInEditor bool      `yaml:"openInEditor"`
-	Verbose      bool      `yaml:"verbose"`
-	Session      Session   `yaml:"session,inline"`
-	Username     string    `yaml:"username"`
-	IsPremium    bool      `yaml:"isPremium"`
-	Tracks       []string  `yaml:"tracks"`
+	workspace     Workspace `yaml:"workspace"`
+	language      Language  `yaml:"language"`
+	OpenInEditor  bool      `yaml:"openInEditor"`
+	Verbose       bool      `yaml:"verbose"`
+	Session       Session   `yaml:"session,inline"`
+	Username      string    `yaml:"username"`
+	IsPremium     bool      `yaml:"isPremium"`
+	Tracks        []string  `yaml:"tracks"`
+	MaxRetries    int       `yaml:"maxRetries"`
+	RateLimit     float64   `yaml:"rateLimit"`
+	ResultTimeout int       `yaml:"resultTimeout"`
+	ListHeight    int       `yaml:"listHeight"`
+	// Languages adds to or overrides the built-in language registry
+	Languages []domain.LanguageSpec `yaml:"languages"`
 }
 
 func (c *Config) WorkspacePath() string { return c.workspace.String() }
 func (c *Config) LanguageName() string  { return c.language.String() }
 func (c *Config) HasValidSession() bool { return c.Session.IsValid() }
+func (c *Config) ResultWait() time.Duration {
+	return time.Duration(c.ResultTimeout) * time.Second
+}
 
 func (c Config) MarshalYAML() (any, error) {
-	return map[string]any{
-		"workspace":    c.workspace.String(),
-		"language":     c.language.String(),
-		"openInEditor": c.OpenInEditor,
-		"verbose":      c.Verbose,
-		"sessionToken": c.Session.SessionToken,
-		"csrfToken":    c.Session.CsrfToken,
-		"username":     c.Username,
-		"isPremium":    c.IsPremium,
-		"tracks":       c.Tracks,
-	}, nil
+	values := map[string]any{
+		"workspace":     c.workspace.String(),
+		"language":      c.language.String(),
+		"openInEditor":  c.OpenInEditor,
+		"verbose":       c.Verbose,
+		"sessionToken":  c.Session.SessionToken,
+		"csrfToken":     c.Session.CsrfToken,
+		"username":      c.Username,
+		"isPremium":     c.IsPremium,
+		"tracks":        c.Tracks,
+		"maxRetries":    c.MaxRetries,
+		"rateLimit":     c.RateLimit,
+		"resultTimeout": c.ResultTimeout,
+		"listHeight":    c.ListHeight,
+	}
+	if len(c.Languages) > 0 {
+		values["languages"] = c.Languages
+	}
+	return values, nil
 }
 
 func (c *Config) UnmarshalYAML(unmarshal func(any) error) error {
 	var raw struct {
-		Workspace    string   `yaml:"workspace"`
-		Language     string   `yaml:"language"`
-		OpenInEditor bool     `yaml:"openInEditor"`
-		Verbose      bool     `yaml:"verbose"`
-		SessionToken string   `yaml:"sessionToken"`
-		CsrfToken    string   `yaml:"csrfToken"`
-		Username     string   `yaml:"username"`
-		IsPremium    bool     `yaml:"isPremium"`
-		Tracks       []string `yaml:"tracks"`
+		Workspace     string                `yaml:"workspace"`
+		Language      string                `yaml:"language"`
+		OpenInEditor  bool                  `yaml:"openInEditor"`
+		Verbose       bool                  `yaml:"verbose"`
+		SessionToken  string                `yaml:"sessionToken"`
+		CsrfToken     string                `yaml:"csrfToken"`
+		Username      string                `yaml:"username"`
+		IsPremium     bool                  `yaml:"isPremium"`
+		Tracks        []string              `yaml:"tracks"`
+		MaxRetries    *int                  `yaml:"maxRetries"`
+		RateLimit     *float64              `yaml:"rateLimit"`
+		ResultTimeout *int                  `yaml:"resultTimeout"`
+		ListHeight    *int                  `yaml:"listHeight"`
+		Languages     []domain.LanguageSpec `yaml:"languages"`
 	}
 
 	if err := unmarshal(&raw); err != nil {
@@ -69,6 +94,28 @@ func (c *Config) UnmarshalYAML(unmarshal func(any) error) error {
 	c.Username = raw.Username
 	c.IsPremium = raw.IsPremium
 	c.Tracks = raw.Tracks
+	c.Languages = raw.Languages
+
+	// Older config files predate these settings, keep the defaults when unset
+	c.MaxRetries = DefaultMaxRetries
+	if raw.MaxRetries != nil {
+		c.MaxRetries = *raw.MaxRetries
+	}
+
+	c.RateLimit = DefaultRateLimit
+	if raw.RateLimit != nil {
+		c.RateLimit = *raw.RateLimit
+	}
+
+	c.ResultTimeout = DefaultResultTimeout
+	if raw.ResultTimeout != nil {
+		c.ResultTimeout = *raw.ResultTimeout
+	}
+
+	c.ListHeight = DefaultListHeight
+	if raw.ListHeight != nil {
+		c.ListHeight = *raw.ListHeight
+	}
 
 	return nil
 }
@@ -93,6 +140,15 @@ type Language string
 
 const DefaultLanguage = "go"
 
+const (
+	DefaultMaxRetries = 3
+	DefaultRateLimit  = 2.0
+	// DefaultResultTimeout is in seconds
+	DefaultResultTimeout = 30
+	// DefaultListHeight is the number of rows kata list shows at once
+	DefaultListHeight = 10
+)
+
 type LanguageResult struct {
 	Language Language
 	Warning  string
@@ -162,16 +218,13 @@ type ConfigBackup struct {
 }
 
 func NewConfigBackup(cfg *Config) *ConfigBackup {
-	// Deep copy to avoid mutations
-	backup := &Config{
-		workspace:    cfg.workspace,
-		language:     cfg.language,
-		OpenInEditor: cfg.OpenInEditor,
-		Verbose:      cfg.Verbose,
-		Session:      cfg.Session,
-		Username:     cfg.Username,
-		IsPremium:    cfg.IsPremium,
-		Tracks:       slices.Clone(cfg.Tracks),
-	}
-	return &ConfigBackup{Config: backup}
+	// Copy every field, then clone what is shared so edits cannot reach the backup
+	backup := *cfg
+	backup.Tracks = slices.Clone(cfg.Tracks)
+	backup.Languages = slices.Clone(cfg.Languages)
+	for i, spec := range backup.Languages {
+		backup.Languages[i].Aliases = slices.Clone(spec.Aliases)
+		backup.Languages[i].Test.Command = slices.Clone(spec.Test.Command)
+	}
+	return &ConfigBackup{Config: &backup}
 }
@@ -0,0 +1,48 @@
+package config
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/phantompunk/kata/internal/domain"
+	"github.com/phantompunk/kata/pkg/assert"
+)
+
+func TestConfigBackupRestore(t *testing.T) {
+	workspace, err := NewWorkspace("/katas")
+	assert.NilError(t, err)
+	cfg := &Config{
+		workspace:     workspace,
+		language:      Language("golang"),
+		Session:       NewSession("session", "csrf"),
+		Tracks:        []string{"golang", "python3"},
+		MaxRetries:    5,
+		RateLimit:     1.5,
+		ResultTimeout: 45,
+		ListHeight:    20,
+		Languages:     []domain.LanguageSpec{{Name: "elixir", Aliases: []string{"ex"}, Extension: ".ex"}},
+	}
+
+	backup := NewConfigBackup(cfg)
+
+	// A failed edit leaves the loaded config changed
+	cfg.MaxRetries = 0
+	cfg.RateLimit = 0
+	cfg.Tracks[0] = "rust"
+	cfg.Languages[0].Aliases[0] = "exs"
+
+	repo := &ConfigRepository{path: filepath.Join(t.TempDir(), "kata.yml")}
+	assert.NilError(t, repo.Restore(backup))
+	restored, err := repo.Load()
+	assert.NilError(t, err)
+
+	assert.Equal(t, restored.WorkspacePath(), "/katas")
+	assert.Equal(t, restored.LanguageName(), "golang")
+	assert.Equal(t, restored.Session.SessionToken, "session")
+	assert.Equal(t, restored.Tracks[0], "golang")
+	assert.Equal(t, restored.MaxRetries, 5)
+	assert.Equal(t, restored.RateLimit, 1.5)
+	assert.Equal(t, restored.ResultTimeout, 45)
+	assert.Equal(t, restored.ListHeight, 20)
+	assert.Equal(t, restored.Languages[0].Aliases[0], "ex")
+}
@@ -41,6 +41,12 @@ func EnsureDB() (*sql.DB, error) {
 	return db, nil
 }
 
+// Migrate brings an open database, like an in-memory one in tests, up to the
+// latest schema
+func Migrate(db *sql.DB) error {
+	return runMigrations(db)
+}
+
 func runMigrations(db *sql.DB) error {
 	d, err := iofs.New(Migrations, "migrations")
 	if err != nil {
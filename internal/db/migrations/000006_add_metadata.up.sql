@@ -0,0 +1 @@
+ALTER TABLE questions ADD COLUMN metadata TEXT NOT NULL DEFAULT '{}';
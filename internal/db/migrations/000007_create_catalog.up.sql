@@ -0,0 +1,10 @@
+CREATE TABLE catalog (
+  question_id INTEGER PRIMARY KEY,
+  title TEXT NOT NULL,
+  title_slug TEXT UNIQUE NOT NULL,
+  difficulty TEXT NOT NULL,
+  paid_only INTEGER NOT NULL DEFAULT 0,
+  ac_rate REAL NOT NULL DEFAULT 0,
+  tags TEXT NOT NULL DEFAULT '[]',
+  synced_at TEXT NOT NULL DEFAULT (DATE('now'))
+);
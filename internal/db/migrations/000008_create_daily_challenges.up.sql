@@ -0,0 +1,6 @@
+CREATE TABLE daily_challenges (
+  date TEXT PRIMARY KEY,
+  question_id INTEGER NOT NULL,
+  title_slug TEXT NOT NULL,
+  completed INTEGER CHECK (completed IN (0, 1)) NOT NULL DEFAULT 0
+);
@@ -0,0 +1,7 @@
+CREATE TABLE pending_submissions (
+  submission_id TEXT PRIMARY KEY,
+  question_id INTEGER NOT NULL,
+  lang_slug TEXT NOT NULL,
+  created_at TEXT NOT NULL DEFAULT (DATETIME('now')),
+  FOREIGN KEY (question_id) REFERENCES questions(question_id) ON DELETE CASCADE
+);
@@ -0,0 +1,12 @@
+CREATE TABLE custom_testcases (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  question_id INTEGER NOT NULL,
+  input TEXT NOT NULL,
+  expected TEXT NOT NULL DEFAULT '',
+  source TEXT CHECK (source IN ('user', 'submission')) NOT NULL DEFAULT 'user',
+  created_at TEXT NOT NULL DEFAULT (DATETIME('now')),
+  FOREIGN KEY (question_id) REFERENCES questions(question_id) ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX idx_custom_testcases_question_input
+ON custom_testcases(question_id, input);
@@ -0,0 +1,31 @@
+ALTER TABLE submissions ADD COLUMN failed_attempts INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE submissions ADD COLUMN times_solved INTEGER NOT NULL DEFAULT 0;
+
+UPDATE submissions SET
+  failed_attempts = (
+    SELECT COUNT(*) FROM attempts a
+    WHERE a.question_id = submissions.question_id AND a.lang_slug = submissions.lang_slug
+      AND a.kind = 'submit' AND a.verdict <> 'Accepted'
+  ),
+  times_solved = (
+    SELECT COUNT(*) FROM attempts a
+    WHERE a.question_id = submissions.question_id AND a.lang_slug = submissions.lang_slug
+      AND a.kind = 'submit' AND a.verdict = 'Accepted'
+  );
+
+DROP TABLE IF EXISTS attempts;
+
+-- SQLite doesn't support DROP COLUMN, recreate pending_submissions without code_hash
+CREATE TABLE pending_submissions_new (
+  submission_id TEXT PRIMARY KEY,
+  question_id INTEGER NOT NULL,
+  lang_slug TEXT NOT NULL,
+  created_at TEXT NOT NULL DEFAULT (DATETIME('now')),
+  FOREIGN KEY (question_id) REFERENCES questions(question_id) ON DELETE CASCADE
+);
+
+INSERT INTO pending_submissions_new (submission_id, question_id, lang_slug, created_at)
+SELECT submission_id, question_id, lang_slug, created_at FROM pending_submissions;
+
+DROP TABLE pending_submissions;
+ALTER TABLE pending_submissions_new RENAME TO pending_submissions;
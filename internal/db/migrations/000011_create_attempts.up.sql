@@ -0,0 +1,52 @@
+CREATE TABLE attempts (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  question_id INTEGER NOT NULL,
+  lang_slug TEXT NOT NULL,
+  kind TEXT CHECK (kind IN ('test', 'submit')) NOT NULL,
+  verdict TEXT NOT NULL,
+  runtime TEXT NOT NULL DEFAULT '',
+  memory TEXT NOT NULL DEFAULT '',
+  runtime_percentile REAL,
+  memory_percentile REAL,
+  submission_id TEXT NOT NULL DEFAULT '',
+  code_hash TEXT NOT NULL DEFAULT '',
+  created_at TEXT NOT NULL DEFAULT (DATETIME('now')),
+  FOREIGN KEY (question_id) REFERENCES questions(question_id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_attempts_question_lang ON attempts(question_id, lang_slug, created_at);
+
+-- Backfill one attempt per counted run. times_solved counted every run the judge
+-- finished, including test runs and rejected submissions, and failed_attempts
+-- counted runs the judge failed on, so neither says which verdict a run got.
+-- Only the last attempt date was kept, so every backfilled attempt is dated then.
+WITH RECURSIVE seq(n) AS (
+  SELECT 1
+  UNION ALL
+  SELECT n + 1 FROM seq
+  WHERE n < (SELECT COALESCE(MAX(MAX(times_solved, solved) + failed_attempts), 0) FROM submissions)
+)
+INSERT INTO attempts (question_id, lang_slug, kind, verdict, created_at)
+SELECT s.question_id, s.lang_slug, 'submit', 'Unknown', s.last_attempted
+FROM submissions s
+JOIN seq ON seq.n <= MAX(s.times_solved, s.solved) + s.failed_attempts;
+
+-- SQLite doesn't support DROP COLUMN, recreate submissions without the counters
+CREATE TABLE submissions_new (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  question_id INTEGER NOT NULL,
+  lang_slug TEXT NOT NULL,
+  solved INTEGER CHECK (solved IN (0, 1)) NOT NULL,
+  last_attempted TEXT NOT NULL DEFAULT (DATE('now')),
+  FOREIGN KEY (question_id) REFERENCES questions(question_id) ON DELETE CASCADE
+);
+
+INSERT INTO submissions_new (id, question_id, lang_slug, solved, last_attempted)
+SELECT id, question_id, lang_slug, solved, last_attempted FROM submissions;
+
+DROP TABLE submissions;
+ALTER TABLE submissions_new RENAME TO submissions;
+
+CREATE UNIQUE INDEX idx_submissions_question_lang_unique ON submissions(question_id, lang_slug);
+
+ALTER TABLE pending_submissions ADD COLUMN code_hash TEXT NOT NULL DEFAULT '';
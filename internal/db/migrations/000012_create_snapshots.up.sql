@@ -0,0 +1,29 @@
+CREATE TABLE snapshots (
+  code_hash TEXT PRIMARY KEY,
+  code TEXT NOT NULL,
+  created_at TEXT NOT NULL DEFAULT (DATETIME('now'))
+);
+
+-- SQLite can't alter a CHECK constraint, recreate attempts to allow retry snapshots
+CREATE TABLE attempts_new (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  question_id INTEGER NOT NULL,
+  lang_slug TEXT NOT NULL,
+  kind TEXT CHECK (kind IN ('test', 'submit', 'retry')) NOT NULL,
+  verdict TEXT NOT NULL,
+  runtime TEXT NOT NULL DEFAULT '',
+  memory TEXT NOT NULL DEFAULT '',
+  runtime_percentile REAL,
+  memory_percentile REAL,
+  submission_id TEXT NOT NULL DEFAULT '',
+  code_hash TEXT NOT NULL DEFAULT '',
+  created_at TEXT NOT NULL DEFAULT (DATETIME('now')),
+  FOREIGN KEY (question_id) REFERENCES questions(question_id) ON DELETE CASCADE
+);
+
+INSERT INTO attempts_new SELECT * FROM attempts;
+
+DROP TABLE attempts;
+ALTER TABLE attempts_new RENAME TO attempts;
+
+CREATE INDEX idx_attempts_question_lang ON attempts(question_id, lang_slug, created_at);
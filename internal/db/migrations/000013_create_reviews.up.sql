@@ -0,0 +1,19 @@
+CREATE TABLE reviews (
+  question_id INTEGER NOT NULL,
+  lang_slug TEXT NOT NULL,
+  ease REAL NOT NULL DEFAULT 2.5,
+  interval_days INTEGER NOT NULL DEFAULT 0,
+  repetitions INTEGER NOT NULL DEFAULT 0,
+  due TEXT NOT NULL,
+  last_reviewed TEXT NOT NULL,
+  PRIMARY KEY (question_id, lang_slug),
+  FOREIGN KEY (question_id) REFERENCES questions(question_id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_reviews_due ON reviews(due);
+
+-- Solved problems start as if reviewed once on their last attempt
+INSERT INTO reviews (question_id, lang_slug, interval_days, repetitions, due, last_reviewed)
+SELECT question_id, lang_slug, 1, 1, DATE(SUBSTR(last_attempted, 1, 10), '+1 day'), last_attempted
+FROM submissions
+WHERE solved = 1;
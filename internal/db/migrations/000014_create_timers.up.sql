@@ -0,0 +1,7 @@
+CREATE TABLE timers (
+  question_id INTEGER NOT NULL,
+  lang_slug TEXT NOT NULL,
+  started_at TEXT NOT NULL,
+  PRIMARY KEY (question_id, lang_slug),
+  FOREIGN KEY (question_id) REFERENCES questions(question_id) ON DELETE CASCADE
+);
@@ -0,0 +1,22 @@
+-- name: CreateAttempt :exec
+INSERT INTO attempts (
+  question_id, lang_slug, kind, verdict, runtime, memory,
+  runtime_percentile, memory_percentile, submission_id, code_hash, created_at
+) VALUES (
+  ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?
+);
+
+-- name: ListAttempts :many
+SELECT a.id, a.kind, a.verdict, a.runtime, a.memory, a.runtime_percentile, a.memory_percentile,
+  a.submission_id, a.code_hash, a.created_at, COALESCE(s.code, '') AS code
+FROM attempts a
+LEFT JOIN snapshots s ON s.code_hash = a.code_hash
+WHERE a.question_id = ? AND a.lang_slug = ?
+ORDER BY a.id ASC;
+
+-- name: SaveSnapshot :exec
+INSERT INTO snapshots (
+  code_hash, code, created_at
+) VALUES (
+  ?, ?, ?
+) ON CONFLICT(code_hash) DO NOTHING;
@@ -0,0 +1,20 @@
+-- name: UpsertCatalog :exec
+INSERT INTO catalog (
+  question_id, title, title_slug, difficulty, paid_only, ac_rate, tags, synced_at
+) VALUES (
+  ?, ?, ?, ?, ?, ?, ?, ?
+) ON CONFLICT(question_id) DO UPDATE SET
+    title       = excluded.title,
+    title_slug  = excluded.title_slug,
+    difficulty  = excluded.difficulty,
+    paid_only   = excluded.paid_only,
+    ac_rate     = excluded.ac_rate,
+    tags        = excluded.tags,
+    synced_at   = excluded.synced_at;
+
+-- name: GetCatalogSlug :one
+SELECT title_slug FROM catalog
+WHERE question_id = ? LIMIT 1;
+
+-- name: CountCatalog :one
+SELECT COUNT(*) FROM catalog;
@@ -0,0 +1,18 @@
+-- name: SaveDailyChallenge :exec
+INSERT INTO daily_challenges (
+  date, question_id, title_slug
+) VALUES (
+  ?, ?, ?
+) ON CONFLICT(date) DO UPDATE SET
+    question_id = excluded.question_id,
+    title_slug  = excluded.title_slug;
+
+-- name: CompleteDailyChallenge :exec
+UPDATE daily_challenges
+SET completed = 1
+WHERE date = ? AND title_slug = ?;
+
+-- name: ListCompletedDailyDates :many
+SELECT date FROM daily_challenges
+WHERE completed = 1
+ORDER BY date DESC;
@@ -0,0 +1,14 @@
+-- name: SavePendingSubmission :exec
+INSERT INTO pending_submissions (
+  submission_id, question_id, lang_slug, created_at, code_hash
+) VALUES (
+  ?, ?, ?, ?, ?
+) ON CONFLICT(submission_id) DO NOTHING;
+
+-- name: GetPendingSubmission :one
+SELECT * FROM pending_submissions
+WHERE submission_id = ? LIMIT 1;
+
+-- name: DeletePendingSubmission :exec
+DELETE FROM pending_submissions
+WHERE submission_id = ?;
@@ -12,17 +12,32 @@ ORDER BY question_id ASC;
 
 -- name: Create :one
 INSERT INTO questions (
-  question_id, submit_id, title, title_slug, difficulty, function_name, content, code_snippets, test_cases, paid_only, created_at
+  question_id, submit_id, title, title_slug, difficulty, function_name, content, code_snippets, test_cases, paid_only, metadata, created_at
 ) VALUES (
-  ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?
+  ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?
 ) ON CONFLICT(question_id) DO UPDATE SET
     title       = excluded.title,
     title_slug  = excluded.title_slug,
     difficulty  = excluded.difficulty,
     paid_only   = excluded.paid_only,
+    metadata    = excluded.metadata,
     created_at  = excluded.created_at
 RETURNING *;
 
+-- name: Refresh :one
+UPDATE questions SET
+    title         = ?,
+    title_slug    = ?,
+    difficulty    = ?,
+    function_name = ?,
+    content       = ?,
+    code_snippets = ?,
+    test_cases    = ?,
+    paid_only     = ?,
+    metadata      = ?
+WHERE question_id = ?
+RETURNING *;
+
 -- name: GetRandom :one
 SELECT q.question_id, q.title, q.title_slug, q.difficulty,
   CASE
@@ -54,34 +69,17 @@ INSERT INTO submissions (
     last_attempted  = excluded.last_attempted
 RETURNING *;
 
+-- name: RecordTestRun :exec
+INSERT INTO submissions (
+  question_id, lang_slug, solved, last_attempted
+) VALUES (
+  ?, ?, 0, ?
+) ON CONFLICT(question_id, lang_slug) DO UPDATE SET
+    last_attempted  = excluded.last_attempted;
+
 -- name: GetStats :one
 SELECT
     COUNT(DISTINCT q.question_id) AS attempted,
     COUNT(DISTINCT CASE WHEN s.solved = 1 THEN s.question_id END) AS completed
 FROM questions q
 LEFT JOIN submissions s on q.question_id = s.question_id;
-
--- name: GetRandomWeighted :one
-SELECT q.question_id, q.title, q.title_slug, q.difficulty, q.code_snippets, q.function_name,
-  CASE WHEN s.solved = 1 THEN 'Completed' ELSE 'Attempted' END AS status,
-  COALESCE(s.last_attempted, q.created_at) AS last_attempted,
-  (
-    CAST(julianday('now') - julianday(COALESCE(s.last_attempted, q.created_at)) AS REAL) * 0.4 +
-    COALESCE(s.failed_attempts, 0) * 0.3 +
-    CASE q.difficulty WHEN 'Easy' THEN 0.3 WHEN 'Medium' THEN 0.6 ELSE 1.0 END * 0.2 +
-    CASE WHEN COALESCE(s.times_solved, 0) = 1 THEN 0.1 ELSE 0.0 END
-  ) AS weight_score
-FROM questions q
-LEFT JOIN submissions s ON s.question_id = q.question_id
-ORDER BY weight_score DESC, RANDOM()
-LIMIT 1;
-
--- name: IncrementFailedAttempts :exec
-UPDATE submissions
-SET failed_attempts = failed_attempts + 1, last_attempted = ?
-WHERE question_id = ? AND lang_slug = ?;
-
--- name: IncrementTimesSolved :exec
-UPDATE submissions
-SET times_solved = times_solved + 1, solved = 1, last_attempted = ?
-WHERE question_id = ? AND lang_slug = ?;
@@ -0,0 +1,27 @@
+-- name: GetReview :one
+SELECT * FROM reviews
+WHERE question_id = ? AND lang_slug = ? LIMIT 1;
+
+-- name: SaveReview :exec
+INSERT INTO reviews (
+  question_id, lang_slug, ease, interval_days, repetitions, due, last_reviewed
+) VALUES (
+  ?, ?, ?, ?, ?, ?, ?
+) ON CONFLICT(question_id, lang_slug) DO UPDATE SET
+    ease          = excluded.ease,
+    interval_days = excluded.interval_days,
+    repetitions   = excluded.repetitions,
+    due           = excluded.due,
+    last_reviewed = excluded.last_reviewed;
+
+-- name: ListDueReviews :many
+SELECT r.question_id, q.title, q.title_slug, q.difficulty, r.lang_slug, r.interval_days, r.due, r.last_reviewed
+FROM reviews r
+JOIN questions q ON q.question_id = r.question_id
+WHERE r.due <= ?
+ORDER BY r.due ASC, q.question_id ASC;
+
+-- name: GetNextReviewDate :one
+SELECT due FROM reviews
+ORDER BY due ASC
+LIMIT 1;
@@ -0,0 +1,16 @@
+-- name: AddCustomTestcase :exec
+INSERT INTO custom_testcases (
+  question_id, input, expected, source, created_at
+) VALUES (
+  ?, ?, ?, ?, ?
+) ON CONFLICT(question_id, input) DO UPDATE SET
+    expected = CASE WHEN excluded.expected = '' THEN custom_testcases.expected ELSE excluded.expected END;
+
+-- name: ListCustomTestcases :many
+SELECT * FROM custom_testcases
+WHERE question_id = ?
+ORDER BY id ASC;
+
+-- name: DeleteCustomTestcase :exec
+DELETE FROM custom_testcases
+WHERE question_id = ? AND input = ?;
@@ -0,0 +1,15 @@
+-- name: StartTimer :exec
+INSERT INTO timers (
+  question_id, lang_slug, started_at
+) VALUES (
+  ?, ?, ?
+) ON CONFLICT(question_id, lang_slug) DO UPDATE SET
+    started_at = excluded.started_at;
+
+-- name: GetTimer :one
+SELECT * FROM timers
+WHERE question_id = ? AND lang_slug = ? LIMIT 1;
+
+-- name: DeleteTimer :exec
+DELETE FROM timers
+WHERE question_id = ? AND lang_slug = ?;
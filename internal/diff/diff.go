@@ -0,0 +1,120 @@
+// Package diff renders line based unified diffs between two versions of a file
+package diff
+
+import (
+	"fmt"
+	"strings"
+)
+
+// contextLines is the number of unchanged lines shown around each change
+const contextLines = 3
+
+type edit struct {
+	op   byte // ' ' unchanged, '-' removed, '+' added
+	text string
+	a, b int // zero based line in each version before this edit
+}
+
+// Unified returns a unified diff turning from into to, or an empty string
+// when both are identical
+func Unified(fromName, toName, from, to string) string {
+	edits := lineEdits(splitLines(from), splitLines(to))
+
+	var changes []int
+	for i, e := range edits {
+		if e.op != ' ' {
+			changes = append(changes, i)
+		}
+	}
+	if len(changes) == 0 {
+		return ""
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "--- %s\n+++ %s\n", fromName, toName)
+
+	for i := 0; i < len(changes); {
+		start := max(0, changes[i]-contextLines)
+		end := min(len(edits), changes[i]+contextLines+1)
+
+		// Merge changes whose context overlaps into the same hunk
+		for i++; i < len(changes) && changes[i]-contextLines <= end; i++ {
+			end = min(len(edits), changes[i]+contextLines+1)
+		}
+		writeHunk(&b, edits[start:end])
+	}
+	return b.String()
+}
+
+func writeHunk(b *strings.Builder, edits []edit) {
+	var fromCount, toCount int
+	for _, e := range edits {
+		if e.op != '+' {
+			fromCount++
+		}
+		if e.op != '-' {
+			toCount++
+		}
+	}
+
+	fmt.Fprintf(b, "@@ -%s +%s @@\n", hunkRange(edits[0].a, fromCount), hunkRange(edits[0].b, toCount))
+	for _, e := range edits {
+		fmt.Fprintf(b, "%c%s\n", e.op, e.text)
+	}
+}
+
+// hunkRange formats a hunk's start and length. An empty range points at the
+// line before it, as in GNU diff.
+func hunkRange(start, count int) string {
+	if count == 0 {
+		return fmt.Sprintf("%d,0", start)
+	}
+	if count == 1 {
+		return fmt.Sprintf("%d", start+1)
+	}
+	return fmt.Sprintf("%d,%d", start+1, count)
+}
+
+// lineEdits walks a longest common subsequence table to list the unchanged,
+// removed and added lines in order
+func lineEdits(from, to []string) []edit {
+	n, m := len(from), len(to)
+	lcs := make([][]int, n+1)
+	for i := range lcs {
+		lcs[i] = make([]int, m+1)
+	}
+	for i := n - 1; i >= 0; i-- {
+		for j := m - 1; j >= 0; j-- {
+			if from[i] == to[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	edits := make([]edit, 0, n+m)
+	i, j := 0, 0
+	for i < n || j < m {
+		switch {
+		case i < n && j < m && from[i] == to[j]:
+			edits = append(edits, edit{op: ' ', text: from[i], a: i, b: j})
+			i++
+			j++
+		case j == m || (i < n && lcs[i+1][j] >= lcs[i][j+1]):
+			edits = append(edits, edit{op: '-', text: from[i], a: i, b: j})
+			i++
+		default:
+			edits = append(edits, edit{op: '+', text: to[j], a: i, b: j})
+			j++
+		}
+	}
+	return edits
+}
+
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+}
@@ -0,0 +1,57 @@
+package diff
+
+import (
+	"testing"
+
+	"github.com/phantompunk/kata/pkg/assert"
+)
+
+func TestUnified(t *testing.T) {
+	tests := []struct {
+		name     string
+		from, to string
+		expected string
+	}{
+		{
+			name:     "identical",
+			from:     "a\nb\n",
+			to:       "a\nb\n",
+			expected: "",
+		},
+		{
+			name: "changed line",
+			from: "a\nb\nc\n",
+			to:   "a\nx\nc\n",
+			expected: "--- 1\n+++ 2\n" +
+				"@@ -1,3 +1,3 @@\n a\n-b\n+x\n c\n",
+		},
+		{
+			name: "added to empty",
+			from: "",
+			to:   "a\nb",
+			expected: "--- 1\n+++ 2\n" +
+				"@@ -0,0 +1,2 @@\n+a\n+b\n",
+		},
+		{
+			name: "distant changes split hunks",
+			from: "1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n",
+			to:   "0\n2\n3\n4\n5\n6\n7\n8\n9\n11\n",
+			expected: "--- 1\n+++ 2\n" +
+				"@@ -1,4 +1,4 @@\n-1\n+0\n 2\n 3\n 4\n" +
+				"@@ -7,4 +7,4 @@\n 7\n 8\n 9\n-10\n+11\n",
+		},
+		{
+			name: "close changes share a hunk",
+			from: "1\n2\n3\n4\n5\n",
+			to:   "0\n2\n3\n4\n6\n",
+			expected: "--- 1\n+++ 2\n" +
+				"@@ -1,5 +1,5 @@\n-1\n+0\n 2\n 3\n 4\n-5\n+6\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, Unified("1", "2", tt.from, tt.to), tt.expected)
+		})
+	}
+}
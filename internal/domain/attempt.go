@@ -0,0 +1,29 @@
+package domain
+
+import "time"
+
+// Attempt kinds recorded in a problem's history
+const (
+	AttemptTest   = "test"
+	AttemptSubmit = "submit"
+	AttemptRetry  = "retry"
+)
+
+// Attempt is a test run, submission or retry snapshot from a problem's history.
+// Number counts attempts per problem and language, starting at one.
+type Attempt struct {
+	Number       int       `json:"number"`
+	Kind         string    `json:"kind"`
+	Verdict      string    `json:"verdict"`
+	Runtime      string    `json:"runtime,omitempty"`
+	Memory       string    `json:"memory,omitempty"`
+	SubmissionID string    `json:"submissionId,omitempty"`
+	Code         string    `json:"code,omitempty"`
+	CreatedAt    time.Time `json:"createdAt"`
+}
+
+// HasCode reports whether the attempt's code was kept. Attempts recorded
+// before snapshots existed only have a verdict.
+func (a Attempt) HasCode() bool {
+	return a.Code != ""
+}
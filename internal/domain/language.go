@@ -0,0 +1,232 @@
+package domain
+
+import (
+	_ "embed"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/go-yaml/yaml"
+)
+
+//go:embed languages.yml
+var languagesFile []byte
+
+// Languages is the registry every language name is resolved against. It
+// starts with the embedded languages and is extended from the user config.
+var Languages = mustLoadLanguages(languagesFile)
+
+// DefaultTemplate renders solutions of languages without their own template
+const DefaultTemplate = "solution"
+
+// Snippet markers wrap the code kata submits from a solution file,
+// MarkerShared followed by a name includes a helper from the shared library
+const (
+	MarkerStart  = "::KATA START::"
+	MarkerEnd    = "::KATA END::"
+	MarkerShared = "::KATA SHARED::"
+)
+
+// LanguageSpec describes how kata stubs, tests and submits a language,
+// languages.yml documents each field
+type LanguageSpec struct {
+	Name             string      `yaml:"name"`
+	DisplayName      string      `yaml:"displayName,omitempty"`
+	Aliases          []string    `yaml:"aliases,omitempty"`
+	Slug             string      `yaml:"slug,omitempty"`
+	LeetCode         string      `yaml:"leetcode,omitempty"`
+	Extension        string      `yaml:"extension"`
+	TestExtension    string      `yaml:"testExtension,omitempty"`
+	HarnessExtension string      `yaml:"harnessExtension,omitempty"`
+	Comment          string      `yaml:"comment,omitempty"`
+	Template         string      `yaml:"template,omitempty"`
+	TestTemplate     string      `yaml:"testTemplate,omitempty"`
+	Test             TestCommand `yaml:"test,omitempty"`
+}
+
+// TestCommand runs a language's generated tests locally. Parser names the
+// output format the runner reads, e.g. gotest, pytest or jest.
+type TestCommand struct {
+	Command []string `yaml:"command,omitempty"`
+	Parser  string   `yaml:"parser,omitempty"`
+}
+
+// LanguageRegistry resolves language names and aliases to their specs
+type LanguageRegistry struct {
+	specs   map[string]LanguageSpec
+	aliases map[string]string
+}
+
+func NewLanguageRegistry() *LanguageRegistry {
+	return &LanguageRegistry{
+		specs:   map[string]LanguageSpec{},
+		aliases: map[string]string{},
+	}
+}
+
+// LoadLanguageRegistry reads a registry from YAML with a top level languages list
+func LoadLanguageRegistry(data []byte) (*LanguageRegistry, error) {
+	var file struct {
+		Languages []LanguageSpec `yaml:"languages"`
+	}
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("failed to parse languages: %w", err)
+	}
+
+	registry := NewLanguageRegistry()
+	for _, spec := range file.Languages {
+		if err := registry.Register(spec); err != nil {
+			return nil, err
+		}
+	}
+	return registry, nil
+}
+
+func mustLoadLanguages(data []byte) *LanguageRegistry {
+	registry, err := LoadLanguageRegistry(data)
+	if err != nil {
+		panic(err)
+	}
+	return registry
+}
+
+// Register adds a language or replaces the one with the same name. Its
+// aliases take over names already used by other languages.
+func (r *LanguageRegistry) Register(spec LanguageSpec) error {
+	spec, err := spec.withDefaults()
+	if err != nil {
+		return err
+	}
+
+	for alias, name := range r.aliases {
+		if name == spec.Name {
+			delete(r.aliases, alias)
+		}
+	}
+
+	r.specs[spec.Name] = spec
+	r.aliases[spec.Name] = spec.Name
+	for _, alias := range spec.Aliases {
+		r.aliases[normalizeName(alias)] = spec.Name
+	}
+	return nil
+}
+
+// Clone returns a copy of the registry that can change without affecting it
+func (r *LanguageRegistry) Clone() *LanguageRegistry {
+	return &LanguageRegistry{specs: maps.Clone(r.specs), aliases: maps.Clone(r.aliases)}
+}
+
+// Unregister removes a language and its aliases. Aliases it took over from
+// other languages are not given back.
+func (r *LanguageRegistry) Unregister(name string) {
+	name = normalizeName(name)
+	for alias, canonical := range r.aliases {
+		if canonical == name {
+			delete(r.aliases, alias)
+		}
+	}
+	delete(r.specs, name)
+}
+
+// Lookup finds a language by name or alias, ignoring case and whitespace
+func (r *LanguageRegistry) Lookup(name string) (LanguageSpec, bool) {
+	canonical, ok := r.aliases[normalizeName(name)]
+	if !ok {
+		return LanguageSpec{}, false
+	}
+	return r.specs[canonical], true
+}
+
+// Names lists the canonical language names in alphabetical order
+func (r *LanguageRegistry) Names() []string {
+	names := make([]string, 0, len(r.specs))
+	for name := range r.specs {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+func (s LanguageSpec) withDefaults() (LanguageSpec, error) {
+	s.Name = normalizeName(s.Name)
+	if s.Name == "" {
+		return s, errors.New("language name cannot be empty")
+	}
+	if s.Extension == "" {
+		return s, fmt.Errorf("language %q needs an extension", s.Name)
+	}
+	if s.TestTemplate != "" && s.TestExtension == "" {
+		return s, fmt.Errorf("language %q needs a testExtension for its testTemplate", s.Name)
+	}
+	if len(s.Test.Command) > 0 && s.Test.Parser == "" {
+		return s, fmt.Errorf("language %q needs a parser for its test command", s.Name)
+	}
+
+	if s.DisplayName == "" {
+		s.DisplayName = s.Name
+	}
+	if s.Slug == "" {
+		s.Slug = s.Name
+	}
+	if s.LeetCode == "" {
+		s.LeetCode = s.Name
+	}
+	if s.Comment == "" {
+		s.Comment = "//"
+	}
+	if s.Template == "" {
+		s.Template = DefaultTemplate
+	}
+	return s, nil
+}
+
+func normalizeName(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
+
+// Language is a resolved language a problem is stubbed and submitted in
+type Language struct {
+	spec LanguageSpec
+}
+
+// NewProgrammingLanguage resolves a language name or alias. Unknown names
+// fall back to the generic template so callers never get an empty language.
+func NewProgrammingLanguage(name string) Language {
+	spec, ok := Languages.Lookup(name)
+	if !ok {
+		spec, _ = LanguageSpec{Name: name, Extension: "." + normalizeName(name)}.withDefaults()
+	}
+	return Language{spec: spec}
+}
+
+func (l Language) Name() string             { return l.spec.Name }
+func (l Language) Slug() string             { return l.spec.Slug }
+func (l Language) LeetCodeSlug() string     { return l.spec.LeetCode }
+func (l Language) Extension() string        { return l.spec.Extension }
+func (l Language) DisplayName() string      { return l.spec.DisplayName }
+func (l Language) TemplateName() string     { return l.spec.Template }
+func (l Language) TestTemplate() string     { return l.spec.TestTemplate }
+func (l Language) TestExtension() string    { return l.spec.TestExtension }
+func (l Language) HarnessExtension() string { return l.spec.HarnessExtension }
+func (l Language) TestCommand() TestCommand { return l.spec.Test }
+
+// Comment returns the language's comment syntax, suffix is empty for line comments
+func (l Language) Comment() (prefix, suffix string) {
+	prefix, suffix, _ = strings.Cut(l.spec.Comment, " ")
+	return prefix, suffix
+}
+
+// StartMarker and EndMarker are the snippet markers written as comments in the language
+func (l Language) StartMarker() string { return l.marker(MarkerStart) }
+func (l Language) EndMarker() string   { return l.marker(MarkerEnd) }
+
+func (l Language) marker(text string) string {
+	prefix, suffix := l.Comment()
+	if suffix == "" {
+		return prefix + " " + text
+	}
+	return prefix + " " + text + " " + suffix
+}
@@ -0,0 +1,101 @@
+package domain
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/phantompunk/kata/pkg/assert"
+)
+
+func TestLanguageRegistry(t *testing.T) {
+	t.Run("Embedded languages resolve by alias", func(t *testing.T) {
+		registry, err := LoadLanguageRegistry(languagesFile)
+		assert.NilError(t, err)
+
+		spec, ok := registry.Lookup(" Go ")
+		assert.True(t, ok)
+		assert.Equal(t, spec.Name, "golang")
+		assert.Equal(t, spec.Slug, "go")
+		assert.Equal(t, spec.TestTemplate, "gotest")
+
+		spec, ok = registry.Lookup("py")
+		assert.True(t, ok)
+		assert.Equal(t, spec.LeetCode, "python3")
+		assert.Equal(t, spec.Comment, "#")
+
+		spec, ok = registry.Lookup("java")
+		assert.True(t, ok)
+		assert.Equal(t, spec.Template, DefaultTemplate)
+
+		_, ok = registry.Lookup("elixir")
+		assert.False(t, ok)
+	})
+
+	t.Run("Registered languages get defaults", func(t *testing.T) {
+		registry := NewLanguageRegistry()
+		assert.NilError(t, registry.Register(LanguageSpec{Name: "Elixir", Aliases: []string{"ex"}, Extension: ".ex", Comment: "#"}))
+
+		spec, ok := registry.Lookup("ex")
+		assert.True(t, ok)
+		assert.Equal(t, spec.Name, "elixir")
+		assert.Equal(t, spec.Slug, "elixir")
+		assert.Equal(t, spec.LeetCode, "elixir")
+		assert.Equal(t, spec.Template, DefaultTemplate)
+	})
+
+	t.Run("Overrides replace the language and its aliases", func(t *testing.T) {
+		registry, err := LoadLanguageRegistry(languagesFile)
+		assert.NilError(t, err)
+
+		variant := LanguageSpec{Name: "go-generics", Aliases: []string{"go"}, LeetCode: "golang", Extension: ".go", Template: "golang"}
+		assert.NilError(t, registry.Register(variant))
+		spec, _ := registry.Lookup("go")
+		assert.Equal(t, spec.Name, "go-generics")
+
+		assert.NilError(t, registry.Register(LanguageSpec{Name: "golang", Extension: ".go"}))
+		spec, _ = registry.Lookup("golang")
+		assert.Equal(t, spec.Slug, "golang")
+		assert.Equal(t, spec.TestTemplate, "")
+	})
+
+	t.Run("Unregistered languages no longer resolve", func(t *testing.T) {
+		registry := NewLanguageRegistry()
+		assert.NilError(t, registry.Register(LanguageSpec{Name: "Elixir", Aliases: []string{"ex"}, Extension: ".ex"}))
+
+		registry.Unregister("elixir")
+		_, ok := registry.Lookup("ex")
+		assert.False(t, ok)
+		assert.Equal(t, len(registry.Names()), 0)
+	})
+
+	t.Run("Invalid languages are rejected", func(t *testing.T) {
+		registry := NewLanguageRegistry()
+		assert.NotNil(t, registry.Register(LanguageSpec{Extension: ".ex"}))
+		assert.NotNil(t, registry.Register(LanguageSpec{Name: "elixir"}))
+		assert.NotNil(t, registry.Register(LanguageSpec{Name: "elixir", Extension: ".ex", TestTemplate: "exunit"}))
+		assert.Equal(t, len(registry.Names()), 0)
+	})
+}
+
+func TestNewProgrammingLanguage(t *testing.T) {
+	lang := NewProgrammingLanguage("ts")
+	assert.Equal(t, lang.Slug(), "typescript")
+	assert.Equal(t, lang.DisplayName(), "TypeScript")
+	assert.Equal(t, lang.TestExtension(), ".test.ts")
+	assert.Equal(t, lang.HarnessExtension(), "")
+	assert.True(t, slices.Contains(lang.TestCommand().Command, `{"transform":{"^.+\\.ts$":["ts-jest",{"diagnostics":false}]}}`))
+	assert.Equal(t, lang.TestCommand().Parser, "jest")
+
+	unknown := NewProgrammingLanguage("cobol")
+	assert.Equal(t, unknown.Slug(), "cobol")
+	assert.Equal(t, unknown.Extension(), ".cobol")
+	assert.Equal(t, unknown.TemplateName(), DefaultTemplate)
+}
+
+func TestLanguageMarkers(t *testing.T) {
+	assert.Equal(t, NewProgrammingLanguage("python").StartMarker(), "# ::KATA START::")
+	assert.Equal(t, NewProgrammingLanguage("go").EndMarker(), "// ::KATA END::")
+
+	block := Language{spec: LanguageSpec{Comment: "/* */"}}
+	assert.Equal(t, block.StartMarker(), "/* ::KATA START:: */")
+}
@@ -20,51 +20,55 @@ type Problem struct {
 	Code          string
 	Difficulty    string
 	FunctionName  string
+	Params        []Param
+	ReturnType    string
 	Testcases     []string
+	CustomCases   []Testcase
 	Status        string
 	LastAttempted time.Time
 	PaidOnly      bool
 	Language      Language
 	DirectoryPath Path
-	FileSet       []ProblemFile // Solution, Test, Readme
+	FileSet       []ProblemFile // Solution, Test, Readme, Harness (when supported)
 }
 
 func (p *Problem) SolutionPath() string { return p.FileSet[0].Path.String() }
 func (p *Problem) SolutionExists() bool { return p.FileSet[0].Path.Exists() }
+func (p *Problem) TestPath() string     { return p.FileSet[1].Path.String() }
+func (p *Problem) TestExists() bool     { return p.FileSet[1].Path.Exists() }
 
-func (p *Problem) GetID() int {
-	id, _ := strconv.Atoi(p.ID)
-	return id
-}
+func (p *Problem) TestcasesPath() Path { return p.DirectoryPath.Join(TestcasesFile) }
+
+// SharedDir is the helper library next to a language's problems, solutions
+// include its files with the ::KATA SHARED:: marker
+const SharedDir = "shared"
+
+// SharedPath returns the helper library of the problem's language
+func (p *Problem) SharedPath() Path { return Path(p.DirectoryPath.Dir()).Join(SharedDir) }
 
-type Language struct {
-	slug          string //ts
-	displayName   string //Typescript
-	templateName  string //typescript
-	testTemplate  string //tsjest
-	fileExtension string //.ts
-	testExtension string //.test.ts
+// AllTestcases returns the example inputs followed by the custom ones
+func (p *Problem) AllTestcases() []string {
+	inputs := append([]string{}, p.Testcases...)
+	for _, c := range p.CustomCases {
+		inputs = append(inputs, c.Input)
+	}
+	return inputs
 }
 
-func NewProgrammingLanguage(name string) Language {
-	solution, test := resolveTemplateNames(name)
-	displayName, slug, extension, testext := resolveLanguageMetadata(name)
-	return Language{
-		slug:          slug,
-		displayName:   displayName,
-		templateName:  solution,
-		testTemplate:  test,
-		fileExtension: extension,
-		testExtension: testext,
+// HarnessPath returns the path of the generated judge harness, if the language supports one
+func (p *Problem) HarnessPath() (string, bool) {
+	for _, file := range p.FileSet {
+		if file.Type == HarnessFile {
+			return file.Path.String(), true
+		}
 	}
+	return "", false
 }
 
-func (l Language) Slug() string          { return l.slug }
-func (l Language) Extension() string     { return l.fileExtension }
-func (l Language) DisplayName() string   { return l.displayName }
-func (l Language) TemplateName() string  { return l.templateName }
-func (l Language) TestTemplate() string  { return l.testTemplate }
-func (l Language) TestExtension() string { return l.testExtension }
+func (p *Problem) GetID() int {
+	id, _ := strconv.Atoi(p.ID)
+	return id
+}
 
 type ProblemFile struct {
 	Type     FileType //solution
@@ -73,7 +77,7 @@ type ProblemFile struct {
 }
 
 func NewProblemFileSet(baseName string, lang Language, directory Path) []ProblemFile {
-	return []ProblemFile{
+	fileSet := []ProblemFile{
 		{
 			Type:     SolutionFile,
 			Path:     directory.Join(fmt.Sprintf("%s%s", baseName, lang.Extension())),
@@ -90,6 +94,15 @@ func NewProblemFileSet(baseName string, lang Language, directory Path) []Problem
 			Language: lang,
 		},
 	}
+
+	if lang.HarnessExtension() != "" {
+		fileSet = append(fileSet, ProblemFile{
+			Type:     HarnessFile,
+			Path:     directory.Join(fmt.Sprintf("%s%s", baseName, lang.HarnessExtension())),
+			Language: lang,
+		})
+	}
+	return fileSet
 }
 
 type Path string
@@ -147,81 +160,10 @@ const (
 	SolutionFile FileType = "solution"
 	TestFile     FileType = "test"
 	ReadmeFile   FileType = "readme"
+	HarnessFile  FileType = "harness"
 )
 
 type CodeSnippet struct {
 	Code     string `json:"code"`
 	LangSlug string `json:"langSlug"`
 }
-
-func resolveTemplateNames(slug string) (string, string) {
-	switch slug {
-	case "go", "golang":
-		return "golang", "gotest"
-	case "python", "python3":
-		return "python3", "pytest"
-	case "js", "javascript":
-		return "javascript", "jest"
-	case "ts", "typescript":
-		return "typescript", "jest-ts"
-	case "java":
-		return "java", ""
-	case "csharp", "c#":
-		return "csharp", ""
-	case "cpp", "c++":
-		return "cpp", ""
-	case "c":
-		return "c", ""
-	case "rust":
-		return "rust", ""
-	case "ruby":
-		return "ruby", ""
-	case "swift":
-		return "swift", ""
-	case "kotlin":
-		return "kotlin", ""
-	case "scala":
-		return "scala", ""
-	case "php":
-		return "php", ""
-	default:
-		// For languages without specific templates, use generic fallbacks
-		// Solution template will work, but test template may not exist
-		return "solution", ""
-	}
-}
-
-func resolveLanguageMetadata(slug string) (string, string, string, string) {
-	switch slug {
-	case "go", "golang":
-		return "Go", "go", ".go", "_test.go"
-	case "python", "python3":
-		return "Python", "python", ".py", "_test.py"
-	case "js", "javascript":
-		return "JavaScript", "javascript", ".js", ".test.js"
-	case "ts", "typescript":
-		return "TypeScript", "typescript", ".ts", ".test.ts"
-	case "rust":
-		return "Rust", "rust", ".rs", ""
-	case "c":
-		return "C", "c", ".c", ""
-	case "csharp", "c#":
-		return "C#", "csharp", ".cs", ""
-	case "cpp", "c++":
-		return "C++", "cpp", ".cpp", ""
-	case "java":
-		return "Java", "java", ".java", ""
-	case "ruby":
-		return "Ruby", "ruby", ".rb", ""
-	case "swift":
-		return "Swift", "swift", ".swift", ""
-	case "kotlin":
-		return "Kotlin", "kotlin", ".kt", ""
-	case "scala":
-		return "Scala", "scala", ".scala", ""
-	case "php":
-		return "PHP", "php", ".php", ""
-	default:
-		return slug, slug, slug, slug
-	}
-}
@@ -1,8 +1,35 @@
 package domain
 
+import "time"
+
+// MinListHeight is the fewest rows the list and browser tables can show, the
+// config falls back to its default below it
+const MinListHeight = 3
+
 type QuestionStat struct {
 	ID         string
 	Title      string
+	Slug       string
 	Difficulty string
-	LangStatus map[string]bool
+	Tracks     map[string]TrackStat
+}
+
+// TrackStat is a problem's progress in one tracked language
+type TrackStat struct {
+	Solved         bool
+	FailedAttempts int
+	TimesSolved    int
+	LastAttempted  time.Time
+}
+
+// CatalogEntry is a LeetCode problem from the synced catalog
+type CatalogEntry struct {
+	ID         string   `json:"id"`
+	Title      string   `json:"title"`
+	Slug       string   `json:"slug"`
+	Difficulty string   `json:"difficulty"`
+	PaidOnly   bool     `json:"paidOnly"`
+	AcRate     float64  `json:"acRate"`
+	Tags       []string `json:"tags"`
+	Downloaded bool     `json:"downloaded"`
 }
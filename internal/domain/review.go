@@ -0,0 +1,15 @@
+package domain
+
+import "time"
+
+// Review is a solved problem scheduled for practice in one language
+type Review struct {
+	ID           string    `json:"id"`
+	Title        string    `json:"title"`
+	Slug         string    `json:"slug"`
+	Difficulty   string    `json:"difficulty"`
+	Language     string    `json:"language"`
+	Interval     int       `json:"interval"`
+	Due          time.Time `json:"due"`
+	LastReviewed time.Time `json:"lastReviewed"`
+}
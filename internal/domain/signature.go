@@ -0,0 +1,94 @@
+package domain
+
+import "strings"
+
+// Param is a named, typed argument of a problem's function signature
+type Param struct {
+	Name string `json:"name"`
+	Type string `json:"type"` // LeetCode type, e.g. integer[], list<string>, TreeNode
+}
+
+// ElementType returns the element type of a LeetCode array or list type
+func ElementType(t string) (string, bool) {
+	if strings.HasSuffix(t, "[]") {
+		return strings.TrimSuffix(t, "[]"), true
+	}
+	if strings.HasPrefix(t, "list<") && strings.HasSuffix(t, ">") {
+		return t[len("list<") : len(t)-1], true
+	}
+	return "", false
+}
+
+// GoType converts a LeetCode metadata type into its Go equivalent
+func GoType(t string) string {
+	if elem, ok := ElementType(t); ok {
+		return "[]" + GoType(elem)
+	}
+
+	switch t {
+	case "integer":
+		return "int"
+	case "long":
+		return "int64"
+	case "double", "float":
+		return "float64"
+	case "boolean":
+		return "bool"
+	case "string":
+		return "string"
+	case "character":
+		return "byte"
+	case "void", "":
+		return ""
+	case "ListNode", "TreeNode", "Node":
+		return "*" + t
+	default:
+		return "any"
+	}
+}
+
+// PythonType converts a LeetCode metadata type into a Python type hint
+func PythonType(t string) string {
+	if elem, ok := ElementType(t); ok {
+		return "List[" + PythonType(elem) + "]"
+	}
+
+	switch t {
+	case "integer", "long":
+		return "int"
+	case "double", "float":
+		return "float"
+	case "boolean":
+		return "bool"
+	case "string", "character":
+		return "str"
+	case "void", "":
+		return "None"
+	case "ListNode", "TreeNode", "Node":
+		return "Optional[" + t + "]"
+	default:
+		return "Any"
+	}
+}
+
+// TypeScriptType converts a LeetCode metadata type into its TypeScript equivalent
+func TypeScriptType(t string) string {
+	if elem, ok := ElementType(t); ok {
+		return TypeScriptType(elem) + "[]"
+	}
+
+	switch t {
+	case "integer", "long", "double", "float":
+		return "number"
+	case "boolean":
+		return "boolean"
+	case "string", "character":
+		return "string"
+	case "void", "":
+		return "void"
+	case "ListNode", "TreeNode", "Node":
+		return t + " | null"
+	default:
+		return "any"
+	}
+}
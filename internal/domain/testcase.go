@@ -0,0 +1,91 @@
+package domain
+
+import (
+	"strings"
+)
+
+// TestcasesFile holds a problem's custom test cases inside its directory
+const TestcasesFile = "testcases.txt"
+
+const (
+	expectedPrefix  = "# expected:"
+	testcasesHeader = `# Custom test cases, one value per line and separated by blank lines.
+# Add "# expected: <output>" to a case to check it in local tests.
+`
+)
+
+// Testcase is a custom input in LeetCode's raw serialization, one value per
+// line, with an optional expected output
+type Testcase struct {
+	Input    string `json:"input"`
+	Expected string `json:"expected,omitempty"`
+}
+
+// ParseTestcases reads test cases separated by blank lines. Comment lines are
+// ignored except for "# expected:" lines.
+func ParseTestcases(content string) []Testcase {
+	var cases []Testcase
+	var current Testcase
+	var lines []string
+
+	flush := func() {
+		if len(lines) > 0 {
+			current.Input = strings.Join(lines, "\n")
+			cases = append(cases, current)
+		}
+		current, lines = Testcase{}, nil
+	}
+
+	for _, line := range strings.Split(strings.ReplaceAll(content, "\r\n", "\n"), "\n") {
+		trimmed := strings.TrimSpace(line)
+		switch {
+		case trimmed == "":
+			flush()
+		case strings.HasPrefix(trimmed, expectedPrefix):
+			current.Expected = strings.TrimSpace(strings.TrimPrefix(trimmed, expectedPrefix))
+		case strings.HasPrefix(trimmed, "#"):
+		default:
+			lines = append(lines, trimmed)
+		}
+	}
+	flush()
+
+	return cases
+}
+
+// FormatTestcases writes test cases in the format read by ParseTestcases
+func FormatTestcases(cases []Testcase) string {
+	var b strings.Builder
+	b.WriteString(testcasesHeader)
+	for _, c := range cases {
+		b.WriteString("\n")
+		b.WriteString(c.Input)
+		b.WriteString("\n")
+		if c.Expected != "" {
+			b.WriteString(expectedPrefix + " " + c.Expected + "\n")
+		}
+	}
+	return b.String()
+}
+
+// MergeTestcases appends cases whose input is not already present, filling in
+// missing expected outputs for inputs that are
+func MergeTestcases(cases []Testcase, more ...Testcase) []Testcase {
+	for _, c := range more {
+		found := false
+		for i := range cases {
+			if cases[i].Input == c.Input {
+				found = true
+				if cases[i].Expected == "" {
+					cases[i].Expected = c.Expected
+				}
+				break
+			}
+		}
+
+		if !found {
+			cases = append(cases, c)
+		}
+	}
+	return cases
+}
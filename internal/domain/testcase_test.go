@@ -0,0 +1,37 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/phantompunk/kata/pkg/assert"
+)
+
+func TestParseTestcases(t *testing.T) {
+	t.Run("Cases separated by blank lines", func(t *testing.T) {
+		content := "# header\n\n[2,7,11,15]\n9\n# expected: [0,1]\n\n\n[3,3]\n6\n"
+		cases := ParseTestcases(content)
+
+		assert.Equal(t, len(cases), 2)
+		assert.Equal(t, cases[0].Input, "[2,7,11,15]\n9")
+		assert.Equal(t, cases[0].Expected, "[0,1]")
+		assert.Equal(t, cases[1].Input, "[3,3]\n6")
+		assert.Equal(t, cases[1].Expected, "")
+	})
+
+	t.Run("Round trip", func(t *testing.T) {
+		cases := []Testcase{{Input: "[1]\n1", Expected: "[0]"}, {Input: "\"abc\""}}
+		parsed := ParseTestcases(FormatTestcases(cases))
+
+		assert.Equal(t, len(parsed), 2)
+		assert.Equal(t, parsed[0], cases[0])
+		assert.Equal(t, parsed[1], cases[1])
+	})
+}
+
+func TestMergeTestcases(t *testing.T) {
+	cases := MergeTestcases([]Testcase{{Input: "[1]"}}, Testcase{Input: "[1]", Expected: "1"}, Testcase{Input: "[2]"})
+
+	assert.Equal(t, len(cases), 2)
+	assert.Equal(t, cases[0].Expected, "1")
+	assert.Equal(t, cases[1].Input, "[2]")
+}
@@ -0,0 +1,144 @@
+package judge
+
+import (
+	"errors"
+	"fmt"
+	"html"
+	"regexp"
+	"strings"
+
+	"github.com/phantompunk/kata/internal/domain"
+)
+
+var (
+	ErrUnsupportedType      = errors.New("type cannot be expressed as a literal")
+	ErrUnsupportedParamType = errors.New("unsupported parameter type")
+	ErrInvalidValue         = errors.New("invalid example value")
+	ErrUnsupportedLanguage  = errors.New("harness is not supported for this language")
+	ErrUnsupportedSignature = errors.New("problem signature is not supported by the harness")
+	ErrMismatchedCases      = errors.New("example inputs and outputs do not match")
+)
+
+// IsUnsupported reports whether an error means the problem's examples cannot
+// be judged locally, rather than that generating them failed
+func IsUnsupported(err error) bool {
+	for _, target := range []error{ErrUnsupportedType, ErrUnsupportedParamType, ErrInvalidValue, ErrUnsupportedLanguage, ErrUnsupportedSignature, ErrMismatchedCases} {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// Example is a single example test case in LeetCode's raw serialization
+type Example struct {
+	Name   string
+	Inputs []string // One raw value per parameter
+	Output string
+}
+
+// Arg is a parameter value rendered as a literal in the target language
+type Arg struct {
+	Name  string
+	Type  string // LeetCode type
+	Value string
+}
+
+// Case is an example rendered for a specific language's test template
+type Case struct {
+	Name     string
+	Args     []Arg
+	Expected string
+}
+
+var (
+	blockPattern  = regexp.MustCompile(`(?i)</?(p|div|pre|br|li)\b[^>]*>`)
+	tagPattern    = regexp.MustCompile(`<[^>]*>`)
+	outputPattern = regexp.MustCompile(`(?m)^\s*Output:\s*(.*?)\s*$`)
+)
+
+// ParseOutputs extracts the expected output of each example from the problem's HTML content
+func ParseOutputs(content string) []string {
+	text := blockPattern.ReplaceAllString(content, "\n")
+	text = html.UnescapeString(tagPattern.ReplaceAllString(text, ""))
+
+	var outputs []string
+	for _, match := range outputPattern.FindAllStringSubmatch(text, -1) {
+		outputs = append(outputs, match[1])
+	}
+	return outputs
+}
+
+// Parse pairs the example inputs with the outputs found in the problem's content,
+// followed by custom cases that have an expected output
+func Parse(problem *domain.Problem) ([]Example, error) {
+	outputs := ParseOutputs(problem.Content)
+	if len(outputs) != len(problem.Testcases) {
+		return nil, fmt.Errorf("%w: %d inputs, %d outputs", ErrMismatchedCases, len(problem.Testcases), len(outputs))
+	}
+
+	examples := make([]Example, 0, len(outputs))
+	for i, testcase := range problem.Testcases {
+		inputs := strings.Split(strings.TrimSpace(testcase), "\n")
+		if len(inputs) != len(problem.Params) {
+			return nil, fmt.Errorf("%w: example %d has %d inputs for %d params", ErrMismatchedCases, i+1, len(inputs), len(problem.Params))
+		}
+		examples = append(examples, Example{Name: fmt.Sprintf("example %d", i+1), Inputs: inputs, Output: outputs[i]})
+	}
+
+	for i, custom := range problem.CustomCases {
+		inputs := strings.Split(strings.TrimSpace(custom.Input), "\n")
+		// Cases without an expected output can only be judged by LeetCode
+		if custom.Expected == "" || len(inputs) != len(problem.Params) {
+			continue
+		}
+		examples = append(examples, Example{Name: fmt.Sprintf("custom %d", i+1), Inputs: inputs, Output: custom.Expected})
+	}
+	return examples, nil
+}
+
+// Cases renders the problem's examples as literals in the given syntax.
+// Signatures without parameters or a return value have no cases, the test
+// templates fall back to an empty table for them. ListNode and TreeNode are
+// only judged by the harness and return ErrUnsupportedParamType.
+func Cases(problem *domain.Problem, syntax Syntax) ([]Case, error) {
+	if len(problem.Params) == 0 || problem.ReturnType == "" || problem.ReturnType == "void" {
+		return nil, nil
+	}
+
+	if NeedsHarness(problem.ReturnType) {
+		return nil, fmt.Errorf("%w: returns %s", ErrUnsupportedParamType, problem.ReturnType)
+	}
+	for _, param := range problem.Params {
+		if NeedsHarness(param.Type) {
+			return nil, fmt.Errorf("%w: %s %s", ErrUnsupportedParamType, param.Name, param.Type)
+		}
+	}
+
+	examples, err := Parse(problem)
+	if err != nil {
+		return nil, err
+	}
+
+	cases := make([]Case, 0, len(examples))
+	for _, example := range examples {
+		c := Case{Name: example.Name}
+
+		for j, param := range problem.Params {
+			value, err := Literal(syntax, param.Type, example.Inputs[j])
+			if err != nil {
+				return nil, err
+			}
+			c.Args = append(c.Args, Arg{Name: param.Name, Type: param.Type, Value: value})
+		}
+
+		expected, err := Literal(syntax, problem.ReturnType, example.Output)
+		if err != nil {
+			return nil, err
+		}
+		c.Expected = expected
+
+		cases = append(cases, c)
+	}
+	return cases, nil
+}
@@ -0,0 +1,75 @@
+package judge
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/phantompunk/kata/internal/domain"
+	"github.com/phantompunk/kata/pkg/assert"
+)
+
+func TestParseOutputs(t *testing.T) {
+	t.Run("Pre formatted examples", func(t *testing.T) {
+		content := "<pre><strong>Input:</strong> s = &quot;III&quot;\n<strong>Output:</strong> 3\n<strong>Explanation:</strong> III = 3.\n</pre>"
+		outputs := ParseOutputs(content)
+
+		assert.Equal(t, len(outputs), 1)
+		assert.Equal(t, outputs[0], "3")
+	})
+
+	t.Run("Example blocks", func(t *testing.T) {
+		content := `<div class="example-block"><p><strong>Input:</strong> <span class="example-io">s = "abc"</span></p><p><strong>Output:</strong> <span class="example-io">"cba"</span></p></div>`
+		outputs := ParseOutputs(content)
+
+		assert.Equal(t, len(outputs), 1)
+		assert.Equal(t, outputs[0], `"cba"`)
+	})
+}
+
+func TestCases(t *testing.T) {
+	problem := &domain.Problem{
+		Content:    "<pre><strong>Output:</strong> [0,1]</pre><pre><strong>Output:</strong> [1,2]</pre>",
+		Testcases:  []string{"[2,7,11,15]\n9", "[3,2,4]\n6"},
+		Params:     []domain.Param{{Name: "nums", Type: "integer[]"}, {Name: "target", Type: "integer"}},
+		ReturnType: "integer[]",
+	}
+
+	cases, err := Cases(problem, Go)
+
+	assert.NilError(t, err)
+	assert.Equal(t, len(cases), 2)
+	assert.Equal(t, cases[1].Args[0].Value, "[]int{3, 2, 4}")
+	assert.Equal(t, cases[1].Expected, "[]int{1, 2}")
+
+	t.Run("Linked lists need the harness", func(t *testing.T) {
+		list := &domain.Problem{
+			Testcases:  []string{"[1,2]"},
+			Params:     []domain.Param{{Name: "head", Type: "ListNode"}},
+			ReturnType: "ListNode",
+		}
+
+		_, err := Cases(list, Go)
+		assert.True(t, errors.Is(err, ErrUnsupportedParamType))
+		assert.True(t, IsUnsupported(err))
+	})
+}
+
+func TestParseCustomCases(t *testing.T) {
+	problem := &domain.Problem{
+		Content:   "<pre><strong>Output:</strong> [0,1]</pre>",
+		Testcases: []string{"[2,7,11,15]\n9"},
+		CustomCases: []domain.Testcase{
+			{Input: "[3,3]\n6", Expected: "[0,1]"},
+			{Input: "[1,2]\n3"},
+		},
+		Params:     []domain.Param{{Name: "nums", Type: "integer[]"}, {Name: "target", Type: "integer"}},
+		ReturnType: "integer[]",
+	}
+
+	examples, err := Parse(problem)
+
+	assert.NilError(t, err)
+	assert.Equal(t, len(examples), 2)
+	assert.Equal(t, examples[1].Name, "custom 1")
+	assert.Equal(t, examples[1].Inputs[0], "[3,3]")
+}
@@ -0,0 +1,125 @@
+package judge
+
+import (
+	"embed"
+	"fmt"
+	"io"
+	"strings"
+	"text/template"
+	"unicode"
+
+	"github.com/phantompunk/kata/internal/domain"
+)
+
+//go:embed templates/*.gohtml
+var files embed.FS
+
+var harnessTemplates = template.Must(template.New("harness").Funcs(template.FuncMap{
+	"goType":   domain.GoType,
+	"argNames": argNames,
+	"title":    title,
+	"inc":      func(i int) int { return i + 1 },
+}).ParseFS(files, "templates/*.gohtml"))
+
+// harnessCase is an example with arguments and expected value rendered as literals
+type harnessCase struct {
+	Name         string
+	Args         []Arg
+	Expected     string
+	ExpectedType string
+}
+
+type harnessView struct {
+	*domain.Problem
+	Module   string
+	Cases    []harnessCase
+	InPlace  bool // Function returns void and modifies its first argument
+	UsesList bool
+	UsesTree bool
+}
+
+// Generate writes a runnable harness that calls the problem's solution with each example
+// and compares the result with the expected output
+func Generate(w io.Writer, problem *domain.Problem) error {
+	name, syntax, ok := harnessTemplate(problem.Language.TestTemplate())
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrUnsupportedLanguage, problem.Language.DisplayName())
+	}
+
+	if len(problem.Params) == 0 || problem.ReturnType == "" {
+		return ErrUnsupportedSignature
+	}
+
+	examples, err := Parse(problem)
+	if err != nil {
+		return err
+	}
+
+	view := harnessView{
+		Problem: problem,
+		Module:  problem.DirName,
+		InPlace: problem.ReturnType == "void",
+	}
+
+	expectedType := problem.ReturnType
+	if view.InPlace {
+		expectedType = problem.Params[0].Type
+	}
+
+	types := []string{expectedType}
+	for _, param := range problem.Params {
+		types = append(types, param.Type)
+	}
+	for _, typ := range types {
+		view.UsesList = view.UsesList || baseType(typ) == "ListNode"
+		view.UsesTree = view.UsesTree || baseType(typ) == "TreeNode"
+	}
+
+	for _, example := range examples {
+		c := harnessCase{Name: example.Name, ExpectedType: expectedType}
+
+		for j, param := range problem.Params {
+			value, err := Literal(syntax, param.Type, example.Inputs[j])
+			if err != nil {
+				return err
+			}
+			c.Args = append(c.Args, Arg{Name: param.Name, Type: param.Type, Value: value})
+		}
+
+		if c.Expected, err = Literal(syntax, expectedType, example.Output); err != nil {
+			return err
+		}
+		view.Cases = append(view.Cases, c)
+	}
+
+	return harnessTemplates.ExecuteTemplate(w, name, view)
+}
+
+func harnessTemplate(testTemplate string) (string, Syntax, bool) {
+	switch testTemplate {
+	case "gotest":
+		return "go-harness", Go, true
+	case "pytest":
+		return "python-harness", Python, true
+	case "jest":
+		return "js-harness", JavaScript, true
+	default:
+		return "", 0, false
+	}
+}
+
+func argNames(args []Arg) string {
+	names := make([]string, len(args))
+	for i, arg := range args {
+		names[i] = arg.Name
+	}
+	return strings.Join(names, ", ")
+}
+
+func title(s string) string {
+	runes := []rune(s)
+	if len(runes) > 0 {
+		runes[0] = unicode.ToUpper(runes[0])
+	}
+	return string(runes)
+}
@@ -0,0 +1,227 @@
+package judge
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/phantompunk/kata/internal/domain"
+)
+
+// Syntax is the target language for rendered literals
+type Syntax int
+
+const (
+	Go Syntax = iota
+	Python
+	JavaScript
+)
+
+// Kind classifies a decoded value
+type Kind int
+
+const (
+	Null Kind = iota
+	Number
+	Bool
+	String
+	Char
+	List
+	LinkedList
+	Tree
+)
+
+// Value is a LeetCode input or output decoded according to its metadata type
+type Value struct {
+	Kind  Kind
+	Type  string  // LeetCode type, e.g. integer[], ListNode
+	Text  string  // Number literal or string contents
+	Bool  bool    // Boolean contents
+	Items []Value // List elements, linked list values or level-order tree values
+}
+
+// Decode parses a raw LeetCode value, e.g. "[1,null,2]" for a TreeNode, into a typed value
+func Decode(typ, raw string) (Value, error) {
+	decoder := json.NewDecoder(bytes.NewReader([]byte(strings.TrimSpace(raw))))
+	decoder.UseNumber()
+
+	var decoded any
+	if err := decoder.Decode(&decoded); err != nil {
+		return Value{}, fmt.Errorf("%w %q: %w", ErrInvalidValue, raw, err)
+	}
+	return build(typ, decoded)
+}
+
+// Literal converts a raw LeetCode value of the given metadata type into a source literal
+func Literal(syntax Syntax, typ, raw string) (string, error) {
+	value, err := Decode(typ, raw)
+	if err != nil {
+		return "", err
+	}
+	return value.Literal(syntax), nil
+}
+
+// NeedsHarness reports whether a type requires harness helpers such as ListNode or TreeNode
+func NeedsHarness(typ string) bool {
+	base := baseType(typ)
+	return base == "ListNode" || base == "TreeNode"
+}
+
+func build(typ string, v any) (Value, error) {
+	if elem, ok := domain.ElementType(typ); ok {
+		if v == nil {
+			return Value{Kind: Null, Type: typ}, nil
+		}
+		items, ok := v.([]any)
+		if !ok {
+			return Value{}, fmt.Errorf("%w: expected array for %s", ErrUnsupportedType, typ)
+		}
+
+		list := Value{Kind: List, Type: typ}
+		for _, item := range items {
+			value, err := build(elem, item)
+			if err != nil {
+				return Value{}, err
+			}
+			list.Items = append(list.Items, value)
+		}
+		return list, nil
+	}
+
+	switch typ {
+	case "ListNode", "TreeNode":
+		items, ok := v.([]any)
+		if !ok {
+			return Value{}, fmt.Errorf("%w: expected array for %s", ErrUnsupportedType, typ)
+		}
+
+		node := Value{Kind: LinkedList, Type: typ}
+		if typ == "TreeNode" {
+			node.Kind = Tree
+		}
+		for _, item := range items {
+			if item == nil && node.Kind == Tree {
+				node.Items = append(node.Items, Value{Kind: Null, Type: "integer"})
+				continue
+			}
+			value, err := build("integer", item)
+			if err != nil {
+				return Value{}, err
+			}
+			node.Items = append(node.Items, value)
+		}
+		return node, nil
+	case "integer", "long", "double", "float":
+		number, ok := v.(json.Number)
+		if !ok {
+			return Value{}, fmt.Errorf("%w: expected number for %s", ErrUnsupportedType, typ)
+		}
+		return Value{Kind: Number, Type: typ, Text: number.String()}, nil
+	case "boolean":
+		b, ok := v.(bool)
+		if !ok {
+			return Value{}, fmt.Errorf("%w: expected boolean", ErrUnsupportedType)
+		}
+		return Value{Kind: Bool, Type: typ, Bool: b}, nil
+	case "string", "character":
+		if v == nil && typ == "string" {
+			return Value{Kind: Null, Type: typ}, nil
+		}
+		s, ok := v.(string)
+		if !ok {
+			return Value{}, fmt.Errorf("%w: expected string for %s", ErrUnsupportedType, typ)
+		}
+		if typ == "character" && len(s) == 1 {
+			return Value{Kind: Char, Type: typ, Text: s}, nil
+		}
+		return Value{Kind: String, Type: typ, Text: s}, nil
+	default:
+		return Value{}, fmt.Errorf("%w: %s", ErrUnsupportedType, typ)
+	}
+}
+
+// Literal renders the value as source code in the given syntax
+func (v Value) Literal(syntax Syntax) string {
+	switch syntax {
+	case Go:
+		return v.goLiteral(true)
+	case Python:
+		return v.scriptLiteral("None", "True", "False", "kata_list", "kata_tree")
+	default:
+		return v.scriptLiteral("null", "true", "false", "kataList", "kataTree")
+	}
+}
+
+// goLiteral renders a Go literal, omitting element types inside composite literals
+func (v Value) goLiteral(typed bool) string {
+	switch v.Kind {
+	case Null:
+		return "nil"
+	case Number:
+		return v.Text
+	case Bool:
+		return strconv.FormatBool(v.Bool)
+	case String:
+		return strconv.Quote(v.Text)
+	case Char:
+		return strconv.QuoteRune(rune(v.Text[0]))
+	case LinkedList:
+		return "kataList(" + joinItems(v.Items, func(item Value) string { return item.goLiteral(false) }) + ")"
+	case Tree:
+		return "kataTree(" + joinItems(v.Items, func(item Value) string { return item.goLiteral(false) }) + ")"
+	default:
+		prefix := ""
+		if typed {
+			prefix = domain.GoType(v.Type)
+		}
+		return prefix + "{" + joinItems(v.Items, func(item Value) string { return item.goLiteral(false) }) + "}"
+	}
+}
+
+// scriptLiteral renders a Python or JavaScript literal, which share list and string syntax
+func (v Value) scriptLiteral(null, trueLit, falseLit, listFunc, treeFunc string) string {
+	format := func(item Value) string {
+		return item.scriptLiteral(null, trueLit, falseLit, listFunc, treeFunc)
+	}
+
+	switch v.Kind {
+	case Null:
+		return null
+	case Number:
+		return v.Text
+	case Bool:
+		if v.Bool {
+			return trueLit
+		}
+		return falseLit
+	case String, Char:
+		data, _ := json.Marshal(v.Text)
+		return string(data)
+	case LinkedList:
+		return listFunc + "([" + joinItems(v.Items, format) + "])"
+	case Tree:
+		return treeFunc + "([" + joinItems(v.Items, format) + "])"
+	default:
+		return "[" + joinItems(v.Items, format) + "]"
+	}
+}
+
+func joinItems(items []Value, format func(Value) string) string {
+	parts := make([]string, len(items))
+	for i, item := range items {
+		parts[i] = format(item)
+	}
+	return strings.Join(parts, ", ")
+}
+
+func baseType(typ string) string {
+	for {
+		elem, ok := domain.ElementType(typ)
+		if !ok {
+			return typ
+		}
+		typ = elem
+	}
+}
@@ -0,0 +1,54 @@
+package judge
+
+import (
+	"testing"
+
+	"github.com/phantompunk/kata/pkg/assert"
+)
+
+func TestLiteral(t *testing.T) {
+	tests := []struct {
+		syntax   Syntax
+		typ      string
+		raw      string
+		expected string
+	}{
+		{Go, "integer[]", "[1,2,3]", "[]int{1, 2, 3}"},
+		{Go, "list<list<integer>>", "[[1],[2,3]]", "[][]int{{1}, {2, 3}}"},
+		{Go, "character[][]", `[["a","b"]]`, "[][]byte{{'a', 'b'}}"},
+		{Go, "string", `"leet"`, `"leet"`},
+		{Python, "boolean", "true", "True"},
+		{Python, "string[]", `["a","b"]`, `["a", "b"]`},
+		{JavaScript, "double", "2.5", "2.5"},
+		{Go, "ListNode", "[1,2,3]", "kataList(1, 2, 3)"},
+		{Go, "TreeNode", "[1,null,2]", "kataTree(1, nil, 2)"},
+		{Go, "ListNode[]", "[[1],[]]", "[]*ListNode{kataList(1), kataList()}"},
+		{Python, "TreeNode", "[1,null,2]", "kata_tree([1, None, 2])"},
+		{JavaScript, "ListNode", "[]", "kataList([])"},
+	}
+
+	for _, tt := range tests {
+		literal, err := Literal(tt.syntax, tt.typ, tt.raw)
+
+		assert.NilError(t, err)
+		assert.Equal(t, literal, tt.expected)
+	}
+
+	_, err := Literal(Go, "Node", "[1,null,2]")
+	assert.True(t, err != nil)
+}
+
+func TestDecode(t *testing.T) {
+	value, err := Decode("TreeNode", "[3,9,20,null,null,15,7]")
+
+	assert.NilError(t, err)
+	assert.Equal(t, value.Kind, Tree)
+	assert.Equal(t, len(value.Items), 7)
+	assert.Equal(t, value.Items[3].Kind, Null)
+
+	_, err = Decode("integer[]", `["a"]`)
+	assert.True(t, err != nil)
+
+	assert.True(t, NeedsHarness("list<ListNode>"))
+	assert.False(t, NeedsHarness("integer[][]"))
+}
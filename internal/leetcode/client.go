@@ -41,6 +41,10 @@ var (
 type Client interface {
 	// FetchQuestion fetches a question by its slug.
 	FetchQuestion(ctx context.Context, slug string) (*Question, error)
+	// FetchProblemset fetches one page of the problem catalog.
+	FetchProblemset(ctx context.Context, skip, limit int) (*ProblemsetPage, error)
+	// FetchDailyChallenge fetches today's daily coding challenge.
+	FetchDailyChallenge(ctx context.Context) (*DailyChallenge, error)
 
 	SubmitTest(ctx context.Context, problem *domain.Problem, snippet string) (string, error)
 	SubmitSolution(ctx context.Context, problem *domain.Problem, snippet string) (string, error)
@@ -56,6 +60,9 @@ type LeetCodeClient struct {
 	client    *http.Client
 	sessionID string
 	csrfToken string
+	retry     RetryPolicy
+	limiter   *rateLimiter
+	sleep     func(ctx context.Context, d time.Duration) error
 }
 
 type Options func(*LeetCodeClient)
@@ -67,6 +74,25 @@ func WithHTTPClient(httpClient *http.Client) Options {
 	}
 }
 
+// WithRetryPolicy sets how GraphQL queries and result polling are retried.
+func WithRetryPolicy(policy RetryPolicy) Options {
+	return func(lc *LeetCodeClient) {
+		lc.retry = policy
+	}
+}
+
+// WithRateLimit limits outgoing requests to rate per second with bursts of
+// up to burst requests. A non-positive rate disables the limiter.
+func WithRateLimit(rate float64, burst int) Options {
+	return func(lc *LeetCodeClient) {
+		if rate <= 0 {
+			lc.limiter = nil
+			return
+		}
+		lc.limiter = newRateLimiter(rate, burst)
+	}
+}
+
 func WithSession(session config.Session) Options {
 	return func(lc *LeetCodeClient) {
 		lc.sessionID = session.SessionToken
@@ -77,6 +103,7 @@ func WithSession(session config.Session) Options {
 func WithClient(httpClient *http.Client) *LeetCodeClient {
 	return &LeetCodeClient{
 		client: httpClient,
+		sleep:  SleepContext,
 	}
 }
 
@@ -87,6 +114,9 @@ func NewClient(opts ...Options) *LeetCodeClient {
 			Timeout: 10 * time.Second,
 			Jar:     jar,
 		},
+		retry:   DefaultRetryPolicy(),
+		limiter: newRateLimiter(DefaultRateLimit, DefaultBurst),
+		sleep:   SleepContext,
 	}
 
 	for _, opt := range opts {
@@ -159,12 +189,86 @@ func (lc *LeetCodeClient) FetchQuestion(ctx context.Context, slug string) (*Ques
 	return &response.Data.Question, nil
 }
 
+func (lc *LeetCodeClient) FetchProblemset(ctx context.Context, skip, limit int) (*ProblemsetPage, error) {
+	query := `
+		query problemsetQuestionList($categorySlug: String, $limit: Int, $skip: Int, $filters: QuestionListFilterInput) {
+			problemsetQuestionList: questionList(categorySlug: $categorySlug, limit: $limit, skip: $skip, filters: $filters) {
+				total: totalNum
+				questions: data {
+					acRate
+					difficulty
+					frontendQuestionId: questionFrontendId
+					paidOnly: isPaidOnly
+					title
+					titleSlug
+					topicTags {
+						name
+						slug
+					}
+				}
+			}
+		}
+	`
+
+	variables := map[string]any{
+		"categorySlug": "",
+		"skip":         skip,
+		"limit":        limit,
+		"filters":      map[string]any{},
+	}
+	res, err := lc.graphQLRequest(ctx, query, variables, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response ProblemsetResponse
+	if err := json.Unmarshal(res, &response); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidResponse, err)
+	}
+
+	return &response.Data.ProblemsetQuestionList, nil
+}
+
+func (lc *LeetCodeClient) FetchDailyChallenge(ctx context.Context) (*DailyChallenge, error) {
+	query := `
+		query questionOfToday {
+			activeDailyCodingChallengeQuestion {
+				date
+				link
+				question {
+					questionFrontendId
+					title
+					titleSlug
+					difficulty
+					paidOnly: isPaidOnly
+				}
+			}
+		}
+	`
+
+	res, err := lc.graphQLRequest(ctx, query, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response DailyChallengeResponse
+	if err := json.Unmarshal(res, &response); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidResponse, err)
+	}
+
+	if response.Data.ActiveDailyCodingChallengeQuestion == nil {
+		return nil, ErrQuestionNotFound
+	}
+
+	return response.Data.ActiveDailyCodingChallengeQuestion, nil
+}
+
 func (lc *LeetCodeClient) SubmitTest(ctx context.Context, problem *domain.Problem, snippet string) (string, error) {
 	payload := map[string]any{
-		"lang":        problem.Language.TemplateName(),
+		"lang":        problem.Language.LeetCodeSlug(),
 		"question_id": problem.SubmitID,
-		"typed_code":  strings.ReplaceAll(snippet, "\t", "    "), // Consistent 4 spaces
-		"data_input":  strings.Join(problem.Testcases, "\n"),
+		"typed_code":  snippet,
+		"data_input":  strings.Join(problem.AllTestcases(), "\n"),
 	}
 
 	url := fmt.Sprintf(testEndpoint, problem.Slug)
@@ -178,9 +282,9 @@ func (lc *LeetCodeClient) SubmitTest(ctx context.Context, problem *domain.Proble
 
 func (lc *LeetCodeClient) SubmitSolution(ctx context.Context, problem *domain.Problem, snippet string) (string, error) {
 	payload := map[string]any{
-		"lang":        problem.Language.TemplateName(),
+		"lang":        problem.Language.LeetCodeSlug(),
 		"question_id": problem.SubmitID,
-		"typed_code":  strings.ReplaceAll(snippet, "\t", "    "), // Consistent 4 spaces
+		"typed_code":  snippet,
 	}
 
 	url := fmt.Sprintf(submitEndpoint, problem.Slug)
@@ -194,7 +298,7 @@ func (lc *LeetCodeClient) SubmitSolution(ctx context.Context, problem *domain.Pr
 
 func (lc *LeetCodeClient) CheckSubmissionResult(ctx context.Context, submissionId string) (*SubmissionResult, error) {
 	url := fmt.Sprintf(submissionEndpoint, submissionId)
-	resp, err := lc.makeRequest(ctx, "GET", url, nil, nil)
+	resp, err := lc.doWithRetry(ctx, "GET", url, nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -324,7 +428,7 @@ func (lc *LeetCodeClient) graphQLRequest(ctx context.Context, query string, vari
 		return nil, err
 	}
 
-	resp, err := lc.makeRequest(ctx, "POST", graphQLEndpoint, bytes.NewBuffer(data), headers)
+	resp, err := lc.doWithRetry(ctx, "POST", graphQLEndpoint, data, headers)
 	if err != nil {
 		return nil, err
 	}
@@ -338,6 +442,12 @@ func (lc *LeetCodeClient) graphQLRequest(ctx context.Context, query string, vari
 }
 
 func (lc *LeetCodeClient) makeRequest(ctx context.Context, method, url string, body io.Reader, headers Headers) (*http.Response, error) {
+	if lc.limiter != nil {
+		if err := lc.limiter.Wait(ctx); err != nil {
+			return nil, err
+		}
+	}
+
 	req, err := http.NewRequestWithContext(ctx, method, url, body)
 	if err != nil {
 		return nil, err
@@ -365,7 +475,7 @@ func handleHttpError(resp *http.Response) error {
 		return ErrUnauthorized
 	case http.StatusTooManyRequests:
 		return ErrRateLimited
-	case http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable:
+	case http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
 		return ErrServerError
 	default:
 		return fmt.Errorf("unexpected status code %d", resp.StatusCode)
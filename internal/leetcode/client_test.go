@@ -6,6 +6,7 @@ import (
 	"io"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/phantompunk/kata/internal/domain"
 	"github.com/phantompunk/kata/pkg/assert"
@@ -39,6 +40,47 @@ func TestFetchQuestion(t *testing.T) {
 
 		assert.NilError(t, err)
 		assert.Equal(t, question.Metadata.Name, "twoSum")
+		assert.Equal(t, len(question.Metadata.Params), 2)
+		assert.Equal(t, question.Metadata.Params[0].Name, "nums")
+		assert.Equal(t, question.Metadata.Params[0].Type, "integer[]")
+		assert.Equal(t, question.Metadata.Params[1].Type, "integer")
+		assert.Equal(t, question.Metadata.Return.Type, "list<list<integer>>")
+	})
+}
+
+func TestFetchProblemset(t *testing.T) {
+	resp := &Responder{}
+	client := newTestClient(resp)
+
+	resp.SetResponse(200, `{"data":{"problemsetQuestionList":{"total":3000,"questions":[{"acRate":55.1,"difficulty":"Easy","frontendQuestionId":"1","paidOnly":false,"title":"Two Sum","titleSlug":"two-sum","topicTags":[{"name":"Array","slug":"array"},{"name":"Hash Table","slug":"hash-table"}]}]}}}`)
+	page, err := client.FetchProblemset(context.Background(), 0, 100)
+
+	assert.NilError(t, err)
+	assert.Equal(t, page.Total, 3000)
+	assert.Equal(t, len(page.Questions), 1)
+	assert.Equal(t, page.Questions[0].TitleSlug, "two-sum")
+	assert.Equal(t, page.Questions[0].TopicTags[1].Slug, "hash-table")
+}
+
+func TestFetchDailyChallenge(t *testing.T) {
+	resp := &Responder{}
+	client := newTestClient(resp)
+
+	t.Run("Challenge found", func(t *testing.T) {
+		resp.SetResponse(200, `{"data":{"activeDailyCodingChallengeQuestion":{"date":"2026-10-17","link":"/problems/two-sum/","question":{"questionFrontendId":"1","title":"Two Sum","titleSlug":"two-sum","difficulty":"Easy","paidOnly":false}}}}`)
+		daily, err := client.FetchDailyChallenge(context.Background())
+
+		assert.NilError(t, err)
+		assert.Equal(t, daily.Date, "2026-10-17")
+		assert.Equal(t, daily.Question.ID, "1")
+		assert.Equal(t, daily.Question.TitleSlug, "two-sum")
+	})
+
+	t.Run("No active challenge", func(t *testing.T) {
+		resp.SetResponse(200, `{"data":{"activeDailyCodingChallengeQuestion":null}}`)
+		_, err := client.FetchDailyChallenge(context.Background())
+
+		assert.Equal(t, err, ErrQuestionNotFound)
 	})
 }
 
@@ -85,16 +127,54 @@ func TestCheckResult(t *testing.T) {
 
 		assert.NilError(t, err)
 		assert.Equal(t, result.State, "SUCCESS")
-		assert.Equal(t, result.Result, "Runtime Error")
-		assert.Equal(t, result.Answer, false)
+		assert.Equal(t, result.Status, StatusRuntimeError)
+		assert.Equal(t, result.Error, "SyntaxError: Invalid or unexpected token")
+	})
+
+	t.Run("Resulted in compile error", func(t *testing.T) {
+		resp.SetResponse(200, `{"state": "SUCCESS", "status_code": 20, "status_msg": "Compile Error", "compile_error": "Line 6: undefined: val", "full_compile_error": "Line 6: Char 17: undefined: val (solution.go)"}`)
+		result, err := client.CheckSubmissionResult(context.Background(), submissionId)
+
+		assert.NilError(t, err)
+		assert.Equal(t, result.Status, StatusCompileError)
+		assert.Equal(t, result.Error, "Line 6: undefined: val")
+		assert.Equal(t, result.FullError, "Line 6: Char 17: undefined: val (solution.go)")
+	})
+
+	t.Run("Test run with wrong answer", func(t *testing.T) {
+		resp.SetResponse(200, `{"state": "SUCCESS", "status_code": 10, "status_msg": "Accepted", "run_success": true, "correct_answer": false, "code_answer": ["[0,1]", "[2,1]"], "expected_code_answer": ["[0,1]", "[1,2]"], "std_output_list": ["", "debug\n", ""], "compare_result": "10", "task_name": "judger.runcodetask.RunCode"}`)
+		result, err := client.CheckSubmissionResult(context.Background(), submissionId)
+
+		assert.NilError(t, err)
+		assert.Equal(t, result.Status, StatusWrongAnswer)
+		assert.Equal(t, len(result.Cases), 2)
+		assert.True(t, result.Cases[0].Passed)
+		assert.False(t, result.Cases[1].Passed)
+		assert.Equal(t, result.Cases[1].Expected, "[1,2]")
+		assert.Equal(t, result.Cases[1].Stdout, "debug\n")
+	})
+
+	t.Run("Submission failed on hidden case", func(t *testing.T) {
+		resp.SetResponse(200, `{"state": "SUCCESS", "status_code": 14, "status_msg": "Time Limit Exceeded", "total_correct": 40, "total_testcases": 63, "last_testcase": "[1,2,3]\n6", "expected_output": "[0,2]", "task_name": "judger.judgetask.Judge"}`)
+		result, err := client.CheckSubmissionResult(context.Background(), submissionId)
+
+		assert.NilError(t, err)
+		assert.Equal(t, result.Status, StatusTimeLimitExceeded)
+		assert.True(t, result.IsSolution)
+		assert.NotNil(t, result.LastCase)
+		assert.Equal(t, result.LastCase.Input, "[1,2,3]\n6")
+		assert.Equal(t, result.TotalCorrect, 40)
 	})
 
 	t.Run("Submit solution", func(t *testing.T) {
-		resp.SetResponse(200, `{"status_runtime":"0 ms","status_memory":"3.9 MB","status_msg":"Accepted"}`)
+		resp.SetResponse(200, `{"status_code": 10, "status_runtime":"0 ms","status_memory":"3.9 MB","status_msg":"Accepted","runtime_percentile":100,"memory_percentile":null,"task_name":"judger.judgetask.Judge"}`)
 		result, err := client.CheckSubmissionResult(context.Background(), submissionId)
 
 		assert.NilError(t, err)
+		assert.True(t, result.IsAccepted())
 		assert.Equal(t, result.Runtime, "0 ms")
+		assert.Equal(t, *result.RuntimePercentile, 100.0)
+		assert.True(t, result.MemoryPercentile == nil)
 	})
 }
 
@@ -143,3 +223,108 @@ func newTestClient(responder *Responder) *LeetCodeClient {
 	client.Transport = responder
 	return WithClient(client)
 }
+
+func TestRetryPolicy(t *testing.T) {
+	var delays []time.Duration
+	newRetryClient := func(resp *SequenceResponder) *LeetCodeClient {
+		delays = nil
+		client := WithClient(&http.Client{Transport: resp})
+		client.retry = RetryPolicy{MaxRetries: 2, BaseDelay: time.Second, MaxDelay: 5 * time.Second}
+		client.sleep = func(ctx context.Context, d time.Duration) error {
+			delays = append(delays, d)
+			return nil
+		}
+		return client
+	}
+
+	t.Run("Query retried after server error", func(t *testing.T) {
+		resp := &SequenceResponder{Statuses: []int{503, 200}, Body: `{"data":{"userStatus":{"isSignedIn":true,"username":"tester"}}}`}
+		client := newRetryClient(resp)
+		username, err := client.GetUsername(context.Background())
+
+		assert.NilError(t, err)
+		assert.Equal(t, username, "tester")
+		assert.Equal(t, resp.Calls, 2)
+		assert.True(t, delays[0] <= time.Second)
+	})
+
+	t.Run("Retry-After honored", func(t *testing.T) {
+		resp := &SequenceResponder{Statuses: []int{429, 200}, RetryAfter: "3", Body: `{"state": "PENDING"}`}
+		client := newRetryClient(resp)
+		_, err := client.CheckSubmissionResult(context.Background(), "12345")
+
+		assert.NilError(t, err)
+		assert.Equal(t, len(delays), 1)
+		assert.Equal(t, delays[0], 3*time.Second)
+	})
+
+	t.Run("Gives up after max retries", func(t *testing.T) {
+		resp := &SequenceResponder{Statuses: []int{500, 500, 500, 200}}
+		client := newRetryClient(resp)
+		_, err := client.GetUsername(context.Background())
+
+		assert.Equal(t, err, ErrServerError)
+		assert.Equal(t, resp.Calls, 3)
+	})
+
+	t.Run("Submissions are not retried", func(t *testing.T) {
+		resp := &SequenceResponder{Statuses: []int{503, 200}}
+		client := newRetryClient(resp)
+		problem := &domain.Problem{ID: "1", Slug: "two-sum", Language: domain.NewProgrammingLanguage("go")}
+		_, err := client.SubmitSolution(context.Background(), problem, "func twoSum(){}")
+
+		assert.NotNil(t, err)
+		assert.Equal(t, resp.Calls, 1)
+	})
+
+	t.Run("Backoff capped at max delay", func(t *testing.T) {
+		policy := DefaultRetryPolicy()
+		for retry := range 10 {
+			assert.True(t, policy.backoff(retry) <= policy.MaxDelay)
+		}
+	})
+
+	t.Run("Retry-After as HTTP date", func(t *testing.T) {
+		now := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+		wait, ok := retryAfter(now.Add(10*time.Second).Format(http.TimeFormat), now)
+
+		assert.True(t, ok)
+		assert.Equal(t, wait, 10*time.Second)
+	})
+}
+
+func TestRateLimiter(t *testing.T) {
+	start := time.Now()
+	limiter := newRateLimiter(2, 2)
+	limiter.last = start
+
+	assert.Equal(t, limiter.reserve(start), time.Duration(0))
+	assert.Equal(t, limiter.reserve(start), time.Duration(0))
+	assert.Equal(t, limiter.reserve(start), 500*time.Millisecond)
+	assert.Equal(t, limiter.reserve(start.Add(time.Second)), time.Duration(0))
+}
+
+// SequenceResponder replies with each status in turn, repeating the last one
+type SequenceResponder struct {
+	Statuses   []int
+	Body       string
+	RetryAfter string
+	Calls      int
+}
+
+func (r *SequenceResponder) RoundTrip(req *http.Request) (*http.Response, error) {
+	status := r.Statuses[min(r.Calls, len(r.Statuses)-1)]
+	r.Calls++
+
+	header := make(http.Header)
+	if r.RetryAfter != "" && status != http.StatusOK {
+		header.Set("Retry-After", r.RetryAfter)
+	}
+
+	return &http.Response{
+		StatusCode: status,
+		Body:       io.NopCloser(bytes.NewReader([]byte(r.Body))),
+		Header:     header,
+		Request:    req,
+	}, nil
+}
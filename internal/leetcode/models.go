@@ -43,7 +43,18 @@ type CodeSnippet struct {
 }
 
 type QuestionMeta struct {
+	Name   string          `json:"name"`
+	Params []QuestionParam `json:"params"`
+	Return QuestionReturn  `json:"return"`
+}
+
+type QuestionParam struct {
 	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+type QuestionReturn struct {
+	Type string `json:"type"`
 }
 
 func (q *Question) UnmarshalJSON(data []byte) error {
@@ -85,6 +96,52 @@ type QuestionReponse struct {
 	} `json:"data"`
 }
 
+type ProblemsetResponse struct {
+	Data struct {
+		ProblemsetQuestionList ProblemsetPage `json:"problemsetQuestionList"`
+	} `json:"data"`
+}
+
+// ProblemsetPage is one page of the problem catalog
+type ProblemsetPage struct {
+	Total     int               `json:"total"`
+	Questions []CatalogQuestion `json:"questions"`
+}
+
+type CatalogQuestion struct {
+	ID         string     `json:"frontendQuestionId"`
+	Title      string     `json:"title"`
+	TitleSlug  string     `json:"titleSlug"`
+	Difficulty string     `json:"difficulty"`
+	PaidOnly   bool       `json:"paidOnly"`
+	AcRate     float64    `json:"acRate"`
+	TopicTags  []TopicTag `json:"topicTags"`
+}
+
+type TopicTag struct {
+	Name string `json:"name"`
+	Slug string `json:"slug"`
+}
+
+type DailyChallengeResponse struct {
+	Data struct {
+		ActiveDailyCodingChallengeQuestion *DailyChallenge `json:"activeDailyCodingChallengeQuestion"`
+	} `json:"data"`
+}
+
+// DailyChallenge is the problem LeetCode features for a given day
+type DailyChallenge struct {
+	Date     string `json:"date"`
+	Link     string `json:"link"`
+	Question struct {
+		ID         string `json:"questionFrontendId"`
+		Title      string `json:"title"`
+		TitleSlug  string `json:"titleSlug"`
+		Difficulty string `json:"difficulty"`
+		PaidOnly   bool   `json:"paidOnly"`
+	} `json:"question"`
+}
+
 type SubmissionID string
 
 // Custom unmarshal to handle both int and string
@@ -139,12 +196,14 @@ func (r SubmitResponse) GetSubmissionID() string {
 type SubmissionResponse struct {
 	SubmissionID       string   `json:"submission_id"`
 	QuestionID         string   `json:"question_id"`
-	State              string   `json:"state"`      // SUCCESS, FAILED, PENDING
-	StatusMsg          string   `json:"status_msg"` // Accepted, Runtime Error, Compile Error
+	State              string   `json:"state"`       // SUCCESS, FAILED, PENDING
+	StatusCode         int      `json:"status_code"` // 10 Accepted, 11 Wrong Answer, 15 Runtime Error
+	StatusMsg          string   `json:"status_msg"`  // Accepted, Runtime Error, Compile Error
+	RunSuccess         bool     `json:"run_success"`
 	Correct            bool     `json:"correct_answer"`
-	RuntimePercentile  float64  `json:"runtime_percentile"`
+	RuntimePercentile  *float64 `json:"runtime_percentile"`
 	StatusRuntime      string   `json:"status_runtime"`
-	MemoryPercentile   float64  `json:"memory_percentile"`
+	MemoryPercentile   *float64 `json:"memory_percentile"`
 	StatusMemory       string   `json:"status_memory"`
 	TotalCorrect       int      `json:"total_correct"`
 	TotalTestcases     int      `json:"total_testcases"`
@@ -154,45 +213,77 @@ type SubmissionResponse struct {
 	FullCompileError   string   `json:"full_compile_error"`
 	CodeAnswer         []string `json:"code_answer"`
 	ExpectedCodeAnswer []string `json:"expected_code_answer"`
+	StdOutputList      []string `json:"std_output_list"`
+	CompareResult      string   `json:"compare_result"`
+	LastTestcase       string   `json:"last_testcase"`
+	CodeOutput         string   `json:"code_output"`
+	ExpectedOutput     string   `json:"expected_output"`
+	StdOutput          string   `json:"std_output"`
 	TaskName           string   `json:"task_name"`
 }
 
 func (s SubmissionResponse) ToResult() *SubmissionResult {
-	errorMessage := s.RuntimeError
-	if errorMessage != "" {
-		errorMessage = s.CompileError
-	}
+	isSolution := s.TaskName == SolutionTask
 
+	errorMessage, fullError := s.RuntimeError, s.FullRuntimeError
 	if s.CompileError != "" {
-		errorMessage = s.CompileError
+		errorMessage, fullError = s.CompileError, s.FullCompileError
 	}
 
-	var testcase int
-	var output, expected string
-	for i, answer := range s.CodeAnswer {
-		if answer != s.ExpectedCodeAnswer[i] {
-			testcase = i
-			output = answer
-			expected = s.ExpectedCodeAnswer[i]
-		}
+	status := parseStatus(s.StatusCode, s.StatusMsg)
+	// Test runs report Accepted whenever the code ran, correctness is separate
+	if !isSolution && status == StatusAccepted && !s.Correct {
+		status = StatusWrongAnswer
 	}
 
-	isSolution := s.TaskName == SolutionTask
-
-	return &SubmissionResult{
-		Answer:            s.Correct,
+	result := &SubmissionResult{
 		State:             s.State,
-		Result:            s.StatusMsg,
+		Status:            status,
+		StatusMsg:         s.StatusMsg,
+		IsSolution:        isSolution,
 		Runtime:           s.StatusRuntime,
-		RuntimePercentile: fmt.Sprintf("%.2f%%", s.RuntimePercentile),
+		RuntimePercentile: s.RuntimePercentile,
 		Memory:            s.StatusMemory,
-		MemoryPercentile:  fmt.Sprintf("%.2f%%", s.MemoryPercentile),
-		ErrorMsg:          errorMessage,
-		TestCase:          testcase,
-		TestOutput:        output,
-		TestExpected:      expected,
-		IsSolution:        isSolution,
+		MemoryPercentile:  s.MemoryPercentile,
+		TotalCorrect:      s.TotalCorrect,
+		TotalTestcases:    s.TotalTestcases,
+		Error:             errorMessage,
+		FullError:         fullError,
+		Cases:             s.testCases(),
+	}
+
+	if isSolution && s.LastTestcase != "" {
+		result.LastCase = &CaseResult{
+			Input:    s.LastTestcase,
+			Output:   s.CodeOutput,
+			Expected: s.ExpectedOutput,
+			Stdout:   s.StdOutput,
+		}
+	}
+
+	return result
+}
+
+// testCases pairs each output of a test run with its expected answer and stdout
+func (s SubmissionResponse) testCases() []CaseResult {
+	var cases []CaseResult
+	for i, answer := range s.CodeAnswer {
+		c := CaseResult{Output: answer}
+		if i < len(s.ExpectedCodeAnswer) {
+			c.Expected = s.ExpectedCodeAnswer[i]
+		}
+		if i < len(s.StdOutputList) {
+			c.Stdout = s.StdOutputList[i]
+		}
+
+		if i < len(s.CompareResult) {
+			c.Passed = s.CompareResult[i] == '1'
+		} else {
+			c.Passed = c.Output == c.Expected
+		}
+		cases = append(cases, c)
 	}
+	return cases
 }
 
 type AuthResponse struct {
@@ -207,25 +298,112 @@ type UserStatus struct {
 	IsPremium  bool   `json:"isPremium"`
 }
 
+// Status is LeetCode's verdict for a test run or submission
+type Status int
+
+const (
+	StatusUnknown Status = iota
+	StatusAccepted
+	StatusWrongAnswer
+	StatusTimeLimitExceeded
+	StatusMemoryLimitExceeded
+	StatusOutputLimitExceeded
+	StatusRuntimeError
+	StatusCompileError
+	StatusInternalError
+)
+
+var statusNames = map[Status]string{
+	StatusUnknown:             "Unknown",
+	StatusAccepted:            "Accepted",
+	StatusWrongAnswer:         "Wrong Answer",
+	StatusTimeLimitExceeded:   "Time Limit Exceeded",
+	StatusMemoryLimitExceeded: "Memory Limit Exceeded",
+	StatusOutputLimitExceeded: "Output Limit Exceeded",
+	StatusRuntimeError:        "Runtime Error",
+	StatusCompileError:        "Compile Error",
+	StatusInternalError:       "Internal Error",
+}
+
+// statusCodes maps LeetCode's numeric status_code to a Status
+var statusCodes = map[int]Status{
+	10: StatusAccepted,
+	11: StatusWrongAnswer,
+	12: StatusMemoryLimitExceeded,
+	13: StatusOutputLimitExceeded,
+	14: StatusTimeLimitExceeded,
+	15: StatusRuntimeError,
+	16: StatusInternalError,
+	20: StatusCompileError,
+}
+
+func (s Status) String() string {
+	return statusNames[s]
+}
+
+// IsError reports whether the code failed to compile or run to completion
+func (s Status) IsError() bool {
+	return s == StatusRuntimeError || s == StatusCompileError || s == StatusInternalError
+}
+
+func parseStatus(code int, msg string) Status {
+	if status, ok := statusCodes[code]; ok {
+		return status
+	}
+
+	for status, name := range statusNames {
+		if status != StatusUnknown && name == msg {
+			return status
+		}
+	}
+	return StatusUnknown
+}
+
+// CaseResult is the outcome of a single test case
+type CaseResult struct {
+	Input    string
+	Output   string
+	Expected string
+	Stdout   string
+	Passed   bool
+}
+
+// SubmissionResult is the verdict of a test run or solution submission
 type SubmissionResult struct {
 	State             string
-	Answer            bool
-	Result            string
+	Status            Status
+	StatusMsg         string
+	IsSolution        bool
 	Runtime           string
-	RuntimePercentile string
+	RuntimePercentile *float64
 	Memory            string
-	MemoryPercentile  string
-	ErrorMsg          string
-	TestCase          int
-	TestOutput        string
-	TestExpected      string
-	IsSolution        bool
+	MemoryPercentile  *float64
+	TotalCorrect      int
+	TotalTestcases    int
+	Error             string
+	FullError         string
+	// Cases lists every case of a test run, inputs are added by the caller
+	Cases []CaseResult
+	// LastCase is the hidden case a submission failed on
+	LastCase *CaseResult
+	// SavedCase is set once LastCase was written to the problem's testcases file
+	SavedCase bool
 }
 
-func (r *SubmissionResult) IsCorrect() bool {
-	return r.Answer
+func (r *SubmissionResult) IsAccepted() bool {
+	return r.Status == StatusAccepted
 }
 
 func (r *SubmissionResult) HasError() bool {
-	return r.ErrorMsg != ""
+	return r.Error != ""
+}
+
+// WithInputs attaches the submitted inputs to their test cases
+func (r *SubmissionResult) WithInputs(inputs []string) *SubmissionResult {
+	for i := range r.Cases {
+		if i < len(inputs) {
+			r.Cases[i].Input = inputs[i]
+		}
+	}
+	return r
 }
@@ -0,0 +1,183 @@
+package leetcode
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	DefaultMaxRetries = 3
+	DefaultRateLimit  = 2.0
+	DefaultBurst      = 4
+)
+
+// RetryPolicy controls how idempotent requests are retried after rate limiting
+// or server errors. The zero value disables retries.
+type RetryPolicy struct {
+	MaxRetries int
+	BaseDelay  time.Duration
+	MaxDelay   time.Duration
+}
+
+// DefaultRetryPolicy returns the policy used by NewClient
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxRetries: DefaultMaxRetries,
+		BaseDelay:  500 * time.Millisecond,
+		MaxDelay:   30 * time.Second,
+	}
+}
+
+// backoff returns a random delay between zero and the exponential ceiling for
+// the given retry, capped at MaxDelay
+func (p RetryPolicy) backoff(retry int) time.Duration {
+	ceiling := p.BaseDelay << retry
+	if ceiling <= 0 || ceiling > p.MaxDelay {
+		ceiling = p.MaxDelay
+	}
+
+	if ceiling <= 0 {
+		return 0
+	}
+	return rand.N(ceiling + 1)
+}
+
+// delay prefers the server's Retry-After header over the computed backoff
+func (p RetryPolicy) delay(resp *http.Response, retry int) time.Duration {
+	if resp != nil {
+		if wait, ok := retryAfter(resp.Header.Get("Retry-After"), time.Now()); ok {
+			if p.MaxDelay > 0 && wait > p.MaxDelay {
+				return p.MaxDelay
+			}
+			return wait
+		}
+	}
+	return p.backoff(retry)
+}
+
+// retryAfter parses a Retry-After header given either in seconds or as an HTTP date
+func retryAfter(header string, now time.Time) (time.Duration, bool) {
+	if header == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(header); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(header); err == nil {
+		wait := date.Sub(now)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+
+	return 0, false
+}
+
+func isRetryable(status int) bool {
+	switch status {
+	case http.StatusTooManyRequests, http.StatusInternalServerError, http.StatusBadGateway,
+		http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
+// SleepContext waits for d or until the context is done, whichever is first
+func SleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// doWithRetry sends an idempotent request, retrying on network failures,
+// rate limiting and server errors according to the client's retry policy
+func (lc *LeetCodeClient) doWithRetry(ctx context.Context, method, url string, body []byte, headers Headers) (*http.Response, error) {
+	for retry := 0; ; retry++ {
+		var reader io.Reader
+		if body != nil {
+			reader = bytes.NewReader(body)
+		}
+
+		resp, err := lc.makeRequest(ctx, method, url, reader, headers)
+		if retry >= lc.retry.MaxRetries || ctx.Err() != nil {
+			return resp, err
+		}
+
+		if err == nil && !isRetryable(resp.StatusCode) {
+			return resp, nil
+		}
+
+		wait := lc.retry.delay(resp, retry)
+		if resp != nil {
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		if err := lc.sleep(ctx, wait); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// rateLimiter is a token bucket allowing bursts of requests while keeping
+// the long run average at rate requests per second
+type rateLimiter struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newRateLimiter(rate float64, burst int) *rateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+	return &rateLimiter{rate: rate, burst: float64(burst), tokens: float64(burst), last: time.Now()}
+}
+
+// reserve takes a token and returns how long the caller must wait before using it
+func (l *rateLimiter) reserve(now time.Time) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.tokens += now.Sub(l.last).Seconds() * l.rate
+	if l.tokens > l.burst {
+		l.tokens = l.burst
+	}
+	l.last = now
+
+	l.tokens--
+	if l.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-l.tokens / l.rate * float64(time.Second))
+}
+
+// Wait blocks until a request is allowed or the context is done
+func (l *rateLimiter) Wait(ctx context.Context) error {
+	return SleepContext(ctx, l.reserve(time.Now()))
+}
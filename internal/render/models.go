@@ -19,6 +19,7 @@ type RenderResult struct {
 	FilesUpdated     []string
 	FilesSkipped     []string
 	TestSkipped      bool
+	Warnings         []string // Files generated without examples or not at all, and why
 }
 
 func NewRenderResult() *RenderResult {
@@ -49,6 +50,10 @@ func (r *RenderResult) RecordAllSkipped() {
 	r.FilesSkipped = append(r.FilesSkipped, "All Files")
 }
 
+func (r *RenderResult) RecordWarning(path domain.Path, err error) {
+	r.Warnings = append(r.Warnings, fmt.Sprintf("%s: %v", path.Basename(), err))
+}
+
 func (r *RenderResult) RecordTestSkipped() {
 	r.TestSkipped = true
 }
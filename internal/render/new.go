@@ -1,6 +1,7 @@
 package render
 
 import (
+	"bytes"
 	"context"
 	"embed"
 	"fmt"
@@ -12,6 +13,7 @@ import (
 
 	htmltomarkdown "github.com/JohannesKaufmann/html-to-markdown/v2"
 	"github.com/phantompunk/kata/internal/domain"
+	"github.com/phantompunk/kata/internal/judge"
 	"github.com/spf13/afero"
 )
 
@@ -20,6 +22,7 @@ var Files embed.FS
 
 type Renderer interface {
 	RenderProblem(ctx context.Context, problem *domain.Problem, force bool, retry bool) (*RenderResult, error)
+	RenderHarness(ctx context.Context, problem *domain.Problem) error
 }
 
 type QuestionRenderer struct {
@@ -31,6 +34,10 @@ func New() (*QuestionRenderer, error) {
 	funcMap := template.FuncMap{
 		"pascalCase": pascalCase,
 		"snakeCase":  snakeCase,
+		"goType":     domain.GoType,
+		"pythonType": domain.PythonType,
+		"tsType":     domain.TypeScriptType,
+		"paramNames": paramNames,
 	}
 
 	templ, err := template.New("new").Funcs(funcMap).ParseFS(Files, "templates/*")
@@ -76,6 +83,28 @@ func (r *QuestionRenderer) RenderProblem(ctx context.Context, problem *domain.Pr
 	return result, nil
 }
 
+// RenderHarness regenerates the judge harness, e.g. after custom test cases
+// change. Problems the harness cannot judge return the judge error.
+func (r *QuestionRenderer) RenderHarness(ctx context.Context, problem *domain.Problem) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	path, ok := problem.HarnessPath()
+	if !ok {
+		return nil
+	}
+
+	var content bytes.Buffer
+	if err := judge.Generate(&content, problem); err != nil {
+		return err
+	}
+	if err := afero.WriteFile(r.fs, path, content.Bytes(), 0644); err != nil {
+		return fmt.Errorf("failed creating file %q: %w", path, err)
+	}
+	return nil
+}
+
 func (r *QuestionRenderer) renderProblemFile(ctx context.Context, problem *domain.Problem, problemFile domain.ProblemFile, force bool, result *RenderResult) error {
 	if err := ctx.Err(); err != nil {
 		return err
@@ -94,14 +123,21 @@ func (r *QuestionRenderer) renderProblemFile(ctx context.Context, problem *domai
 		return nil
 	}
 
-	file, err := r.fs.Create(problemFile.Path.String())
-	if err != nil {
-		return fmt.Errorf("failed creating file %q: %w", problemFile.Path.String(), err)
+	var content bytes.Buffer
+	if err := r.renderFileContent(&content, problem, problemFile); err != nil {
+		if !judge.IsUnsupported(err) {
+			return err
+		}
+		// Not every signature can be judged locally. Tests are still written
+		// with an empty table, a harness without cases is left out.
+		result.RecordWarning(problemFile.Path, err)
+		if problemFile.Type == domain.HarnessFile {
+			return nil
+		}
 	}
-	defer file.Close()
 
-	if err := r.renderFileContent(file, problem, problemFile); err != nil {
-		return err
+	if err := afero.WriteFile(r.fs, problemFile.Path.String(), content.Bytes(), 0644); err != nil {
+		return fmt.Errorf("failed creating file %q: %w", problemFile.Path.String(), err)
 	}
 
 	if fileExists {
@@ -118,28 +154,70 @@ func (r *QuestionRenderer) renderFileContent(w io.Writer, problem *domain.Proble
 		if t := r.templ.Lookup(problem.Language.TemplateName()); t != nil {
 			return r.templ.ExecuteTemplate(w, problem.Language.TemplateName(), problem)
 		}
-		return r.templ.ExecuteTemplate(w, "solution", problem)
+		return r.templ.ExecuteTemplate(w, domain.DefaultTemplate, problem)
 
 	case domain.TestFile:
 		// Skip test file if no template is defined (empty string)
 		if problem.Language.TestTemplate() == "" {
 			return nil
 		}
-		return r.templ.ExecuteTemplate(w, problem.Language.TestTemplate(), problem)
+		// The test file is written without examples it cannot decode, viewErr
+		// still reports why they are missing
+		view, viewErr := newTestView(problem)
+		if err := r.templ.ExecuteTemplate(w, problem.Language.TestTemplate(), view); err != nil {
+			return err
+		}
+		return viewErr
 
 	case domain.ReadmeFile:
-		markdown, err := htmltomarkdown.ConvertString(problem.Content)
+		markdown, err := Markdown(problem.Content)
 		if err != nil {
-			return fmt.Errorf("failed converting to markdown: %w", err)
+			return err
 		}
 
 		mdProblem := *problem
 		mdProblem.Content = markdown
 		return r.templ.ExecuteTemplate(w, string(fileInfo.Type), mdProblem)
+
+	case domain.HarnessFile:
+		return judge.Generate(w, problem)
 	}
 	return nil
 }
 
+// testView exposes the problem along with its examples rendered for the test template
+type testView struct {
+	*domain.Problem
+	Cases []judge.Case
+}
+
+// newTestView returns the view even when the examples cannot be rendered, the
+// template then writes an empty table
+func newTestView(problem *domain.Problem) (testView, error) {
+	view := testView{Problem: problem}
+	syntax, ok := testSyntax(problem.Language.TestTemplate())
+	if !ok {
+		return view, nil
+	}
+
+	cases, err := judge.Cases(problem, syntax)
+	view.Cases = cases
+	return view, err
+}
+
+func testSyntax(testTemplate string) (judge.Syntax, bool) {
+	switch testTemplate {
+	case "gotest":
+		return judge.Go, true
+	case "pytest":
+		return judge.Python, true
+	case "jest", "jest-ts":
+		return judge.JavaScript, true
+	default:
+		return 0, false
+	}
+}
+
 func (r *QuestionRenderer) ensureDirectory(problemDirectory domain.Path) (bool, error) {
 	exists := problemDirectory.Exists()
 	if err := r.fs.MkdirAll(problemDirectory.String(), os.ModePerm); err != nil {
@@ -167,6 +245,28 @@ func pascalCase(s string) string {
 	return result.String()
 }
 
+// paramNames joins parameter names with an optional prefix, e.g. "tc.nums, tc.target"
+func paramNames(prefix string, params []domain.Param) string {
+	names := make([]string, len(params))
+	for i, param := range params {
+		names[i] = prefix + param.Name
+	}
+	return strings.Join(names, ", ")
+}
+
 func snakeCase(s string) string {
 	return strings.ReplaceAll(string(s), "-", "_")
 }
+
+// superscripts keeps exponents like 10<sup>4</sup> readable, markdown has
+// no superscript so the conversion would turn them into 104
+var superscripts = strings.NewReplacer("<sup>", "^", "</sup>", "")
+
+// Markdown converts a problem statement from LeetCode's HTML to markdown
+func Markdown(content string) (string, error) {
+	markdown, err := htmltomarkdown.ConvertString(superscripts.Replace(content))
+	if err != nil {
+		return "", fmt.Errorf("failed converting to markdown: %w", err)
+	}
+	return markdown, nil
+}
@@ -0,0 +1,32 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/phantompunk/kata/internal/domain"
+)
+
+// timestampLayouts covers timestamps written by kata and SQLite's defaults
+var timestampLayouts = []string{time.RFC3339, time.DateTime, time.DateOnly}
+
+func (a ListAttemptsRow) ToAttempt(number int) domain.Attempt {
+	return domain.Attempt{
+		Number:       number,
+		Kind:         a.Kind,
+		Verdict:      a.Verdict,
+		Runtime:      a.Runtime,
+		Memory:       a.Memory,
+		SubmissionID: a.SubmissionID,
+		Code:         a.Code,
+		CreatedAt:    parseTimestamp(a.CreatedAt),
+	}
+}
+
+func parseTimestamp(value string) time.Time {
+	for _, layout := range timestampLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t
+		}
+	}
+	return time.Time{}
+}
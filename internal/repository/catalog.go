@@ -0,0 +1,152 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/phantompunk/kata/internal/domain"
+	"github.com/phantompunk/kata/internal/leetcode"
+)
+
+// CatalogFilter narrows a catalog listing, zero values disable a filter
+type CatalogFilter struct {
+	Difficulty    string
+	Tag           string
+	PaidOnly      bool
+	FreeOnly      bool
+	NotDownloaded bool
+	MinAcRate     float64
+	MaxAcRate     float64
+	Limit         int
+}
+
+// ListCatalog returns catalog entries matching the filter using a parameterized query
+func (q *Queries) ListCatalog(ctx context.Context, filter CatalogFilter) ([]domain.CatalogEntry, error) {
+	query, args := buildCatalogQuery(filter)
+	rows, err := q.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var items []domain.CatalogEntry
+	for rows.Next() {
+		var i domain.CatalogEntry
+		var id, paidOnly, downloaded int64
+		var tags string
+		if err := rows.Scan(&id, &i.Title, &i.Slug, &i.Difficulty, &paidOnly, &i.AcRate, &tags, &downloaded); err != nil {
+			return nil, err
+		}
+
+		i.ID = fmt.Sprintf("%d", id)
+		i.PaidOnly = paidOnly == 1
+		i.Downloaded = downloaded == 1
+		if err := json.Unmarshal([]byte(tags), &i.Tags); err != nil {
+			return nil, fmt.Errorf("failed to decode tags for %q: %w", i.Slug, err)
+		}
+		items = append(items, i)
+	}
+
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+func buildCatalogQuery(filter CatalogFilter) (string, []any) {
+	var conditions []string
+	var args []any
+
+	if filter.Difficulty != "" {
+		conditions = append(conditions, "c.difficulty = ? COLLATE NOCASE")
+		args = append(args, filter.Difficulty)
+	}
+
+	if filter.Tag != "" {
+		conditions = append(conditions, "EXISTS (SELECT 1 FROM json_each(c.tags) WHERE json_each.value = ?)")
+		args = append(args, NormalizeTag(filter.Tag))
+	}
+
+	if filter.PaidOnly {
+		conditions = append(conditions, "c.paid_only = 1")
+	}
+
+	if filter.FreeOnly {
+		conditions = append(conditions, "c.paid_only = 0")
+	}
+
+	if filter.NotDownloaded {
+		conditions = append(conditions, "q.question_id IS NULL")
+	}
+
+	if filter.MinAcRate > 0 {
+		conditions = append(conditions, "c.ac_rate >= ?")
+		args = append(args, filter.MinAcRate)
+	}
+
+	if filter.MaxAcRate > 0 {
+		conditions = append(conditions, "c.ac_rate <= ?")
+		args = append(args, filter.MaxAcRate)
+	}
+
+	query := `SELECT c.question_id, c.title, c.title_slug, c.difficulty, c.paid_only, c.ac_rate, c.tags,
+  CASE WHEN q.question_id IS NULL THEN 0 ELSE 1 END AS downloaded
+FROM catalog c
+LEFT JOIN questions q ON q.question_id = c.question_id`
+
+	if len(conditions) > 0 {
+		query += "\nWHERE " + strings.Join(conditions, " AND ")
+	}
+	query += "\nORDER BY c.question_id ASC"
+
+	if filter.Limit > 0 {
+		query += "\nLIMIT ?"
+		args = append(args, filter.Limit)
+	}
+
+	return query, args
+}
+
+// NormalizeTag converts a topic name such as "Dynamic Programming" into its slug
+func NormalizeTag(tag string) string {
+	return strings.ReplaceAll(strings.ToLower(strings.TrimSpace(tag)), " ", "-")
+}
+
+// ToCatalogParams maps a catalog entry to upsert params, skipping entries without a numeric id
+func ToCatalogParams(question leetcode.CatalogQuestion) (UpsertCatalogParams, bool) {
+	id, err := strconv.ParseInt(question.ID, 10, 64)
+	if err != nil {
+		return UpsertCatalogParams{}, false
+	}
+
+	tags := make([]string, 0, len(question.TopicTags))
+	for _, tag := range question.TopicTags {
+		tags = append(tags, tag.Slug)
+	}
+	encodedTags, _ := json.Marshal(tags)
+
+	var paidOnly int64
+	if question.PaidOnly {
+		paidOnly = 1
+	}
+
+	return UpsertCatalogParams{
+		QuestionID: id,
+		Title:      question.Title,
+		TitleSlug:  question.TitleSlug,
+		Difficulty: question.Difficulty,
+		PaidOnly:   paidOnly,
+		AcRate:     question.AcRate,
+		Tags:       string(encodedTags),
+		SyncedAt:   time.Now().Format(time.RFC3339),
+	}, true
+}
@@ -0,0 +1,179 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/phantompunk/kata/internal/domain"
+)
+
+// List sort orders, activity sorts put the most active problems first
+const (
+	SortID             = "id"
+	SortTitle          = "title"
+	SortDifficulty     = "difficulty"
+	SortLastAttempted  = "last-attempted"
+	SortFailedAttempts = "failed-attempts"
+	SortTimesSolved    = "times-solved"
+)
+
+var ListSorts = []string{SortID, SortTitle, SortDifficulty, SortLastAttempted, SortFailedAttempts, SortTimesSolved}
+
+// Track is a language column group in the list, Name labels the column and
+// LangSlug matches the language of submissions and attempts
+type Track struct {
+	Name     string
+	LangSlug string
+}
+
+// ListFilter narrows and orders the problem list, zero values disable a filter
+type ListFilter struct {
+	Tracks     []Track
+	Difficulty string
+	// Unsolved keeps problems not yet solved in at least one track
+	Unsolved bool
+	Search   string
+	Sort     string
+}
+
+// ListWithStatus returns downloaded problems with their progress in every track.
+// Track languages are bound as parameters, aliases only use the track's position.
+func (q *Queries) ListWithStatus(ctx context.Context, filter ListFilter) ([]domain.QuestionStat, error) {
+	query, args := buildListQuery(filter)
+	rows, err := q.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var items []domain.QuestionStat
+	for rows.Next() {
+		var i domain.QuestionStat
+		var id int64
+		solved := make([]int64, len(filter.Tracks))
+		failed := make([]int64, len(filter.Tracks))
+		times := make([]int64, len(filter.Tracks))
+		last := make([]string, len(filter.Tracks))
+
+		scanArgs := []any{&id, &i.Title, &i.Slug, &i.Difficulty}
+		for t := range filter.Tracks {
+			scanArgs = append(scanArgs, &solved[t], &failed[t], &times[t], &last[t])
+		}
+
+		if err := rows.Scan(scanArgs...); err != nil {
+			return nil, err
+		}
+
+		i.ID = fmt.Sprintf("%d", id)
+		i.Tracks = make(map[string]domain.TrackStat, len(filter.Tracks))
+		for t, track := range filter.Tracks {
+			i.Tracks[track.Name] = domain.TrackStat{
+				Solved:         solved[t] == 1,
+				FailedAttempts: int(failed[t]),
+				TimesSolved:    int(times[t]),
+				LastAttempted:  parseTimestamp(last[t]),
+			}
+		}
+		items = append(items, i)
+	}
+
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+func buildListQuery(filter ListFilter) (string, []any) {
+	var args []any
+	columns := []string{"q.question_id", "q.title", "q.title_slug", "q.difficulty"}
+	joins := []string{"FROM questions q"}
+	var unsolved, lastAttempted, failed, solved []string
+
+	for t, track := range filter.Tracks {
+		s, a := fmt.Sprintf("s%d", t), fmt.Sprintf("a%d", t)
+		columns = append(columns,
+			fmt.Sprintf("COALESCE(%s.solved, 0)", s),
+			fmt.Sprintf("COALESCE(%s.failed_attempts, 0)", a),
+			fmt.Sprintf("COALESCE(%s.times_solved, 0)", a),
+			fmt.Sprintf("COALESCE(%s.last_attempted, %s.last_attempted, '')", a, s),
+		)
+
+		joins = append(joins,
+			fmt.Sprintf("LEFT JOIN submissions %s ON %s.question_id = q.question_id AND %s.lang_slug = ?", s, s, s),
+			fmt.Sprintf(`LEFT JOIN (
+  SELECT question_id,
+    COUNT(CASE WHEN kind = 'submit' AND verdict <> 'Accepted' THEN 1 END) AS failed_attempts,
+    COUNT(CASE WHEN kind = 'submit' AND verdict = 'Accepted' THEN 1 END) AS times_solved,
+    MAX(CASE WHEN kind <> 'retry' THEN created_at END) AS last_attempted
+  FROM attempts
+  WHERE lang_slug = ?
+  GROUP BY question_id
+) %s ON %s.question_id = q.question_id`, a, a),
+		)
+		args = append(args, track.LangSlug, track.LangSlug)
+
+		unsolved = append(unsolved, fmt.Sprintf("COALESCE(%s.solved, 0) = 0", s))
+		lastAttempted = append(lastAttempted, fmt.Sprintf("COALESCE(julianday(COALESCE(%s.last_attempted, %s.last_attempted)), 0)", a, s))
+		failed = append(failed, fmt.Sprintf("COALESCE(%s.failed_attempts, 0)", a))
+		solved = append(solved, fmt.Sprintf("COALESCE(%s.times_solved, 0)", a))
+	}
+
+	var conditions []string
+	if filter.Difficulty != "" {
+		conditions = append(conditions, "q.difficulty = ? COLLATE NOCASE")
+		args = append(args, filter.Difficulty)
+	}
+
+	if filter.Search != "" {
+		conditions = append(conditions, `(q.title LIKE ? ESCAPE '\' OR q.title_slug LIKE ? ESCAPE '\')`)
+		pattern := "%" + escapeLike(filter.Search) + "%"
+		args = append(args, pattern, pattern)
+	}
+
+	if filter.Unsolved && len(unsolved) > 0 {
+		conditions = append(conditions, "("+strings.Join(unsolved, " OR ")+")")
+	}
+
+	query := "SELECT " + strings.Join(columns, ", ") + "\n" + strings.Join(joins, "\n")
+	if len(conditions) > 0 {
+		query += "\nWHERE " + strings.Join(conditions, " AND ")
+	}
+	query += "\nORDER BY " + listOrder(filter.Sort, lastAttempted, failed, solved)
+
+	return query, args
+}
+
+func listOrder(sort string, lastAttempted, failed, solved []string) string {
+	switch {
+	case sort == SortTitle:
+		return "q.title COLLATE NOCASE ASC, q.question_id ASC"
+	case sort == SortDifficulty:
+		return "CASE q.difficulty WHEN 'Easy' THEN 1 WHEN 'Medium' THEN 2 ELSE 3 END ASC, q.question_id ASC"
+	case sort == SortLastAttempted && len(lastAttempted) > 0:
+		return greatest(lastAttempted) + " DESC, q.question_id ASC"
+	case sort == SortFailedAttempts && len(failed) > 0:
+		return "(" + strings.Join(failed, " + ") + ") DESC, q.question_id ASC"
+	case sort == SortTimesSolved && len(solved) > 0:
+		return "(" + strings.Join(solved, " + ") + ") DESC, q.question_id ASC"
+	default:
+		return "q.question_id ASC"
+	}
+}
+
+// greatest picks the largest expression, SQLite's MAX is an aggregate with a single argument
+func greatest(exprs []string) string {
+	if len(exprs) == 1 {
+		return exprs[0]
+	}
+	return "MAX(" + strings.Join(exprs, ", ") + ")"
+}
+
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
+}
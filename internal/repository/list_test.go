@@ -0,0 +1,81 @@
+package repository
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/phantompunk/kata/pkg/assert"
+)
+
+func TestBuildListQuery(t *testing.T) {
+	tracks := []Track{
+		{Name: "Go", LangSlug: "go"},
+		{Name: "Py'thon", LangSlug: "python'; DROP TABLE questions; --"},
+	}
+
+	t.Run("Track slugs are bound arguments", func(t *testing.T) {
+		query, args := buildListQuery(ListFilter{Tracks: tracks})
+
+		assert.False(t, strings.Contains(query, "DROP TABLE"))
+		assert.False(t, strings.Contains(query, "Py'thon"))
+		assert.False(t, strings.Contains(query, "'go'"))
+		assert.Equal(t, fmt.Sprint(args), fmt.Sprint([]any{"go", "go", tracks[1].LangSlug, tracks[1].LangSlug}))
+		assert.Equal(t, strings.Count(query, "?"), len(args))
+	})
+
+	t.Run("Aliases are positional", func(t *testing.T) {
+		query, _ := buildListQuery(ListFilter{Tracks: tracks})
+
+		for i := range tracks {
+			assert.True(t, strings.Contains(query, fmt.Sprintf("LEFT JOIN submissions s%d ON s%d.question_id", i, i)))
+			assert.True(t, strings.Contains(query, fmt.Sprintf(") a%d ON a%d.question_id", i, i)))
+		}
+		assert.False(t, strings.Contains(query, "s2"))
+	})
+
+	t.Run("Search escapes LIKE wildcards", func(t *testing.T) {
+		query, args := buildListQuery(ListFilter{Search: `50%_off\`})
+
+		assert.True(t, strings.Contains(query, `q.title LIKE ? ESCAPE '\'`))
+		assert.Equal(t, len(args), 2)
+		assert.Equal(t, args[0], any(`%50\%\_off\\%`))
+		assert.Equal(t, args[1], args[0])
+	})
+
+	t.Run("Filters follow the track arguments", func(t *testing.T) {
+		query, args := buildListQuery(ListFilter{Tracks: tracks[:1], Difficulty: "easy", Search: "sum", Unsolved: true})
+
+		assert.True(t, strings.Contains(query, "\nWHERE q.difficulty = ? COLLATE NOCASE AND (q.title LIKE ?"))
+		assert.True(t, strings.Contains(query, "AND (COALESCE(s0.solved, 0) = 0)"))
+		assert.Equal(t, fmt.Sprint(args), fmt.Sprint([]any{"go", "go", "easy", "%sum%", "%sum%"}))
+	})
+}
+
+func TestListOrder(t *testing.T) {
+	lastAttempted := []string{"x0", "x1"}
+	failed := []string{"f0", "f1"}
+	solved := []string{"t0"}
+
+	tests := []struct {
+		sort string
+		want string
+	}{
+		{"", "q.question_id ASC"},
+		{SortTitle, "q.title COLLATE NOCASE ASC, q.question_id ASC"},
+		{SortLastAttempted, "MAX(x0, x1) DESC, q.question_id ASC"},
+		{SortFailedAttempts, "(f0 + f1) DESC, q.question_id ASC"},
+		{SortTimesSolved, "(t0) DESC, q.question_id ASC"},
+		{"unknown", "q.question_id ASC"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.sort, func(t *testing.T) {
+			assert.Equal(t, listOrder(tt.sort, lastAttempted, failed, solved), tt.want)
+		})
+	}
+
+	t.Run("Activity sorts without tracks", func(t *testing.T) {
+		assert.Equal(t, listOrder(SortFailedAttempts, nil, nil, nil), "q.question_id ASC")
+	})
+}
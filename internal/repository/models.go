@@ -8,6 +8,56 @@ import (
 	"database/sql"
 )
 
+type Attempt struct {
+	ID                int64
+	QuestionID        int64
+	LangSlug          string
+	Kind              string
+	Verdict           string
+	Runtime           string
+	Memory            string
+	RuntimePercentile sql.NullFloat64
+	MemoryPercentile  sql.NullFloat64
+	SubmissionID      string
+	CodeHash          string
+	CreatedAt         string
+}
+
+type Catalog struct {
+	QuestionID int64
+	Title      string
+	TitleSlug  string
+	Difficulty string
+	PaidOnly   int64
+	AcRate     float64
+	Tags       string
+	SyncedAt   string
+}
+
+type CustomTestcase struct {
+	ID         int64
+	QuestionID int64
+	Input      string
+	Expected   string
+	Source     string
+	CreatedAt  string
+}
+
+type DailyChallenge struct {
+	Date       string
+	QuestionID int64
+	TitleSlug  string
+	Completed  int64
+}
+
+type PendingSubmission struct {
+	SubmissionID string
+	QuestionID   int64
+	LangSlug     string
+	CreatedAt    string
+	CodeHash     string
+}
+
 type Question struct {
 	QuestionID   int64
 	Title        string
@@ -20,14 +70,35 @@ type Question struct {
 	CreatedAt    string
 	SubmitID     sql.NullInt64
 	PaidOnly     int64
+	Metadata     string
+}
+
+type Review struct {
+	QuestionID   int64
+	LangSlug     string
+	Ease         float64
+	IntervalDays int64
+	Repetitions  int64
+	Due          string
+	LastReviewed string
+}
+
+type Snapshot struct {
+	CodeHash  string
+	Code      string
+	CreatedAt string
 }
 
 type Submission struct {
-	ID             int64
-	QuestionID     int64
-	LangSlug       string
-	Solved         int64
-	LastAttempted  string
-	FailedAttempts int64
-	TimesSolved    int64
+	ID            int64
+	QuestionID    int64
+	LangSlug      string
+	Solved        int64
+	LastAttempted string
+}
+
+type Timer struct {
+	QuestionID int64
+	LangSlug   string
+	StartedAt  string
 }
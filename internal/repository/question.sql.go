@@ -12,16 +12,17 @@ import (
 
 const create = `-- name: Create :one
 INSERT INTO questions (
-  question_id, submit_id, title, title_slug, difficulty, function_name, content, code_snippets, test_cases, paid_only, created_at
+  question_id, submit_id, title, title_slug, difficulty, function_name, content, code_snippets, test_cases, paid_only, metadata, created_at
 ) VALUES (
-  ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?
+  ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?
 ) ON CONFLICT(question_id) DO UPDATE SET
     title       = excluded.title,
     title_slug  = excluded.title_slug,
     difficulty  = excluded.difficulty,
     paid_only   = excluded.paid_only,
+    metadata    = excluded.metadata,
     created_at  = excluded.created_at
-RETURNING question_id, title, title_slug, difficulty, function_name, content, code_snippets, test_cases, created_at, submit_id, paid_only
+RETURNING question_id, title, title_slug, difficulty, function_name, content, code_snippets, test_cases, created_at, submit_id, paid_only, metadata
 `
 
 type CreateParams struct {
@@ -35,6 +36,7 @@ type CreateParams struct {
 	CodeSnippets string
 	TestCases    string
 	PaidOnly     int64
+	Metadata     string
 	CreatedAt    string
 }
 
@@ -50,6 +52,7 @@ func (q *Queries) Create(ctx context.Context, arg CreateParams) (Question, error
 		arg.CodeSnippets,
 		arg.TestCases,
 		arg.PaidOnly,
+		arg.Metadata,
 		arg.CreatedAt,
 	)
 	var i Question
@@ -65,6 +68,7 @@ func (q *Queries) Create(ctx context.Context, arg CreateParams) (Question, error
 		&i.CreatedAt,
 		&i.SubmitID,
 		&i.PaidOnly,
+		&i.Metadata,
 	)
 	return i, err
 }
@@ -84,7 +88,7 @@ func (q *Queries) Exists(ctx context.Context, titleSlug string) (int64, error) {
 }
 
 const getByID = `-- name: GetByID :one
-SELECT question_id, title, title_slug, difficulty, function_name, content, code_snippets, test_cases, created_at, submit_id, paid_only FROM questions
+SELECT question_id, title, title_slug, difficulty, function_name, content, code_snippets, test_cases, created_at, submit_id, paid_only, metadata FROM questions
 WHERE question_id = ? LIMIT 1
 `
 
@@ -103,12 +107,13 @@ func (q *Queries) GetByID(ctx context.Context, questionID int64) (Question, erro
 		&i.CreatedAt,
 		&i.SubmitID,
 		&i.PaidOnly,
+		&i.Metadata,
 	)
 	return i, err
 }
 
 const getBySlug = `-- name: GetBySlug :one
-SELECT question_id, title, title_slug, difficulty, function_name, content, code_snippets, test_cases, created_at, submit_id, paid_only FROM questions
+SELECT question_id, title, title_slug, difficulty, function_name, content, code_snippets, test_cases, created_at, submit_id, paid_only, metadata FROM questions
 WHERE title_slug = ? LIMIT 1
 `
 
@@ -127,6 +132,7 @@ func (q *Queries) GetBySlug(ctx context.Context, titleSlug string) (Question, er
 		&i.CreatedAt,
 		&i.SubmitID,
 		&i.PaidOnly,
+		&i.Metadata,
 	)
 	return i, err
 }
@@ -170,51 +176,6 @@ func (q *Queries) GetRandom(ctx context.Context) (GetRandomRow, error) {
 	return i, err
 }
 
-const getRandomWeighted = `-- name: GetRandomWeighted :one
-SELECT q.question_id, q.title, q.title_slug, q.difficulty, q.code_snippets, q.function_name,
-  CASE WHEN s.solved = 1 THEN 'Completed' ELSE 'Attempted' END AS status,
-  COALESCE(s.last_attempted, q.created_at) AS last_attempted,
-  (
-    CAST(julianday('now') - julianday(COALESCE(s.last_attempted, q.created_at)) AS REAL) * 0.4 +
-    COALESCE(s.failed_attempts, 0) * 0.3 +
-    CASE q.difficulty WHEN 'Easy' THEN 0.3 WHEN 'Medium' THEN 0.6 ELSE 1.0 END * 0.2 +
-    CASE WHEN COALESCE(s.times_solved, 0) = 1 THEN 0.1 ELSE 0.0 END
-  ) AS weight_score
-FROM questions q
-LEFT JOIN submissions s ON s.question_id = q.question_id
-ORDER BY weight_score DESC, RANDOM()
-LIMIT 1
-`
-
-type GetRandomWeightedRow struct {
-	QuestionID    int64
-	Title         string
-	TitleSlug     string
-	Difficulty    string
-	CodeSnippets  string
-	FunctionName  string
-	Status        string
-	LastAttempted string
-	WeightScore   interface{}
-}
-
-func (q *Queries) GetRandomWeighted(ctx context.Context) (GetRandomWeightedRow, error) {
-	row := q.db.QueryRowContext(ctx, getRandomWeighted)
-	var i GetRandomWeightedRow
-	err := row.Scan(
-		&i.QuestionID,
-		&i.Title,
-		&i.TitleSlug,
-		&i.Difficulty,
-		&i.CodeSnippets,
-		&i.FunctionName,
-		&i.Status,
-		&i.LastAttempted,
-		&i.WeightScore,
-	)
-	return i, err
-}
-
 const getStats = `-- name: GetStats :one
 SELECT
     COUNT(DISTINCT q.question_id) AS attempted,
@@ -235,42 +196,8 @@ func (q *Queries) GetStats(ctx context.Context) (GetStatsRow, error) {
 	return i, err
 }
 
-const incrementFailedAttempts = `-- name: IncrementFailedAttempts :exec
-UPDATE submissions
-SET failed_attempts = failed_attempts + 1, last_attempted = ?
-WHERE question_id = ? AND lang_slug = ?
-`
-
-type IncrementFailedAttemptsParams struct {
-	LastAttempted string
-	QuestionID    int64
-	LangSlug      string
-}
-
-func (q *Queries) IncrementFailedAttempts(ctx context.Context, arg IncrementFailedAttemptsParams) error {
-	_, err := q.db.ExecContext(ctx, incrementFailedAttempts, arg.LastAttempted, arg.QuestionID, arg.LangSlug)
-	return err
-}
-
-const incrementTimesSolved = `-- name: IncrementTimesSolved :exec
-UPDATE submissions
-SET times_solved = times_solved + 1, solved = 1, last_attempted = ?
-WHERE question_id = ? AND lang_slug = ?
-`
-
-type IncrementTimesSolvedParams struct {
-	LastAttempted string
-	QuestionID    int64
-	LangSlug      string
-}
-
-func (q *Queries) IncrementTimesSolved(ctx context.Context, arg IncrementTimesSolvedParams) error {
-	_, err := q.db.ExecContext(ctx, incrementTimesSolved, arg.LastAttempted, arg.QuestionID, arg.LangSlug)
-	return err
-}
-
 const listAll = `-- name: ListAll :many
-SELECT question_id, title, title_slug, difficulty, function_name, content, code_snippets, test_cases, created_at, submit_id, paid_only FROM questions
+SELECT question_id, title, title_slug, difficulty, function_name, content, code_snippets, test_cases, created_at, submit_id, paid_only, metadata FROM questions
 ORDER BY question_id ASC
 `
 
@@ -295,6 +222,7 @@ func (q *Queries) ListAll(ctx context.Context) ([]Question, error) {
 			&i.CreatedAt,
 			&i.SubmitID,
 			&i.PaidOnly,
+			&i.Metadata,
 		); err != nil {
 			return nil, err
 		}
@@ -309,6 +237,85 @@ func (q *Queries) ListAll(ctx context.Context) ([]Question, error) {
 	return items, nil
 }
 
+const recordTestRun = `-- name: RecordTestRun :exec
+INSERT INTO submissions (
+  question_id, lang_slug, solved, last_attempted
+) VALUES (
+  ?, ?, 0, ?
+) ON CONFLICT(question_id, lang_slug) DO UPDATE SET
+    last_attempted  = excluded.last_attempted
+`
+
+type RecordTestRunParams struct {
+	QuestionID    int64
+	LangSlug      string
+	LastAttempted string
+}
+
+func (q *Queries) RecordTestRun(ctx context.Context, arg RecordTestRunParams) error {
+	_, err := q.db.ExecContext(ctx, recordTestRun, arg.QuestionID, arg.LangSlug, arg.LastAttempted)
+	return err
+}
+
+const refresh = `-- name: Refresh :one
+UPDATE questions SET
+    title         = ?,
+    title_slug    = ?,
+    difficulty    = ?,
+    function_name = ?,
+    content       = ?,
+    code_snippets = ?,
+    test_cases    = ?,
+    paid_only     = ?,
+    metadata      = ?
+WHERE question_id = ?
+RETURNING question_id, title, title_slug, difficulty, function_name, content, code_snippets, test_cases, created_at, submit_id, paid_only, metadata
+`
+
+type RefreshParams struct {
+	Title        string
+	TitleSlug    string
+	Difficulty   string
+	FunctionName string
+	Content      string
+	CodeSnippets string
+	TestCases    string
+	PaidOnly     int64
+	Metadata     string
+	QuestionID   int64
+}
+
+func (q *Queries) Refresh(ctx context.Context, arg RefreshParams) (Question, error) {
+	row := q.db.QueryRowContext(ctx, refresh,
+		arg.Title,
+		arg.TitleSlug,
+		arg.Difficulty,
+		arg.FunctionName,
+		arg.Content,
+		arg.CodeSnippets,
+		arg.TestCases,
+		arg.PaidOnly,
+		arg.Metadata,
+		arg.QuestionID,
+	)
+	var i Question
+	err := row.Scan(
+		&i.QuestionID,
+		&i.Title,
+		&i.TitleSlug,
+		&i.Difficulty,
+		&i.FunctionName,
+		&i.Content,
+		&i.CodeSnippets,
+		&i.TestCases,
+		&i.CreatedAt,
+		&i.SubmitID,
+		&i.PaidOnly,
+		&i.Metadata,
+	)
+	return i, err
+}
+
 const submit = `-- name: Submit :one
 INSERT INTO submissions (
   question_id, lang_slug, solved, last_attempted
@@ -317,7 +324,7 @@ INSERT INTO submissions (
 ) ON CONFLICT(question_id, lang_slug) DO UPDATE SET
     solved  = excluded.solved,
     last_attempted  = excluded.last_attempted
-RETURNING id, question_id, lang_slug, solved, last_attempted, failed_attempts, times_solved
+RETURNING id, question_id, lang_slug, solved, last_attempted
 `
 
 type SubmitParams struct {
@@ -341,8 +348,6 @@ func (q *Queries) Submit(ctx context.Context, arg SubmitParams) (Submission, err
 		&i.LangSlug,
 		&i.Solved,
 		&i.LastAttempted,
-		&i.FailedAttempts,
-		&i.TimesSolved,
 	)
 	return i, err
 }
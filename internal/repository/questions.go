@@ -1,7 +1,6 @@
 package repository
 
 import (
-	"context"
 	"database/sql"
 	"encoding/json"
 	"fmt"
@@ -15,106 +14,77 @@ import (
 	"github.com/phantompunk/kata/internal/leetcode"
 )
 
-func (q *Queries) GetAllWithStatus(ctx context.Context, languages []string) ([]domain.QuestionStat, error) {
-	listAllWithStatuses := buildSelectClause(languages) + buildFromClause(languages)
-	rows, err := q.db.QueryContext(ctx, listAllWithStatuses)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-
-	var items []domain.QuestionStat
-	for rows.Next() {
-		var i domain.QuestionStat
-		i.LangStatus = make(map[string]bool)
-		solvedValues := make([]int, len(languages))
-		scanArgs := []any{&i.ID, &i.Title, &i.Difficulty}
-
-		for i := range languages {
-			scanArgs = append(scanArgs, &solvedValues[i])
-		}
-
-		if err := rows.Scan(scanArgs...); err != nil {
-			return nil, err
-		}
-
-		for idx, lang := range languages {
-			i.LangStatus[lang] = solvedValues[idx] == 1
-		}
-		items = append(items, i)
-	}
+func (q *Question) ToProblem(workspace, language string) (*domain.Problem, error) {
+	problem := newProblem(workspace, language, q.QuestionID, q.TitleSlug)
+	now, _ := time.Parse(time.RFC3339, q.CreatedAt)
 
-	if err := rows.Close(); err != nil {
-		return nil, err
+	var testcases []string
+	if err := json.Unmarshal([]byte(q.TestCases), &testcases); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal test cases: %w", err)
 	}
 
-	if err := rows.Err(); err != nil {
+	code, err := snippetFor(q.CodeSnippets, problem.Language)
+	if err != nil {
 		return nil, err
 	}
 
-	return items, nil
+	problem.SubmitID = fmt.Sprintf("%d", q.SubmitID.Int64)
+	problem.Title = q.Title
+	problem.Content = q.Content
+	problem.Code = code
+	problem.Difficulty = q.Difficulty
+	problem.FunctionName = q.FunctionName
+	problem.Params, problem.ReturnType = parseSignature(q.Metadata)
+	problem.LastAttempted = now
+	problem.Testcases = testcases
+	problem.PaidOnly = q.PaidOnly == 1
+	return problem, nil
 }
 
-func (q *Question) ToProblem(workspace, language string) (*domain.Problem, error) {
-	dir := formatTitleSlug(q.TitleSlug)
+// newProblem fills in what every stored question shares, where the problem
+// lives in the workspace for a language
+func newProblem(workspace, language string, questionID int64, titleSlug string) *domain.Problem {
+	dirName := formatTitleSlug(titleSlug)
 	lang := domain.NewProgrammingLanguage(language)
-	directory := domain.Path(filepath.Join(workspace, lang.Slug(), dir))
-	fileSet := domain.NewProblemFileSet(dir, lang, directory)
-	now, _ := time.Parse(time.RFC3339, q.CreatedAt)
+	directory := domain.Path(filepath.Join(workspace, lang.Slug(), dirName))
 
-	var testcases []string
-	if err := json.Unmarshal([]byte(q.TestCases), &testcases); err != nil {
-		fmt.Println("Failed testcases")
-		return nil, err
+	return &domain.Problem{
+		ID:            fmt.Sprintf("%d", questionID),
+		Slug:          titleSlug,
+		DirName:       dirName,
+		DirectoryPath: directory,
+		Language:      lang,
+		FileSet:       domain.NewProblemFileSet(dirName, lang, directory),
 	}
+}
 
-	var code string
-	var codeSnippets []domain.CodeSnippet
-	if err := json.Unmarshal([]byte(q.CodeSnippets), &codeSnippets); err != nil {
-		fmt.Println("Failed to unmarshal code snippets:", err)
-		return nil, err
+// snippetFor picks the language's starter code from the stored code snippets
+func snippetFor(codeSnippets string, lang domain.Language) (string, error) {
+	var snippets []domain.CodeSnippet
+	if err := json.Unmarshal([]byte(codeSnippets), &snippets); err != nil {
+		return "", fmt.Errorf("failed to unmarshal code snippets: %w", err)
 	}
 
-	for _, snippet := range codeSnippets {
-		if snippet.LangSlug == lang.TemplateName() {
-			code = snippet.Code
-			break
+	for _, snippet := range snippets {
+		if snippet.LangSlug == lang.LeetCodeSlug() {
+			return snippet.Code, nil
 		}
 	}
-
-	return &domain.Problem{
-		ID:            fmt.Sprintf("%d", q.QuestionID),
-		SubmitID:      fmt.Sprintf("%d", q.SubmitID.Int64),
-		Title:         q.Title,
-		Slug:          q.TitleSlug,
-		Content:       q.Content,
-		Code:          code,
-		Difficulty:    q.Difficulty,
-		FunctionName:  q.FunctionName,
-		LastAttempted: now,
-		Testcases:     testcases,
-		PaidOnly:      q.PaidOnly == 1,
-		DirectoryPath: directory,
-		Language:      lang,
-		FileSet:       fileSet,
-	}, nil
+	return "", nil
 }
 
-func buildSelectClause(languages []string) string {
-	selectClause := "SELECT q.question_id, q.title, q.difficulty"
-	for _, lang := range languages {
-		selectClause += fmt.Sprintf(", COALESCE(%s.solved, 0) AS %sSolved", lang, lang)
+// parseSignature decodes the stored question metadata into typed parameters and a return type
+func parseSignature(metadata string) ([]domain.Param, string) {
+	var meta leetcode.QuestionMeta
+	if err := json.Unmarshal([]byte(metadata), &meta); err != nil {
+		return nil, ""
 	}
-	return selectClause
-}
 
-func buildFromClause(languages []string) string {
-	fromClause := " FROM questions q"
-	for _, language := range languages {
-		lang := strings.ToLower(language)
-		fromClause += fmt.Sprintf(" LEFT JOIN submissions %s ON q.question_id = %s.question_id AND %s.lang_slug = '%s'", lang, lang, lang, lang)
+	params := make([]domain.Param, 0, len(meta.Params))
+	for _, param := range meta.Params {
+		params = append(params, domain.Param{Name: param.Name, Type: param.Type})
 	}
-	return fromClause
+	return params, meta.Return.Type
 }
 
 var numberToString = map[string]string{"1": "one", "2": "two", "3": "three", "4": "four", "5": "five", "6": "six", "7": "seven", "8": "eight", "9": "nine", "0": "zero"}
@@ -147,59 +117,12 @@ func hasNumber(name string) bool {
 }
 
 func (q *GetRandomRow) ToProblem(workspace, language string) *domain.Problem {
-	dirName := formatTitleSlug(q.TitleSlug)
-	lang := domain.NewProgrammingLanguage(language)
-	directory := domain.Path(filepath.Join(workspace, lang.Slug(), dirName))
-	fileSet := domain.NewProblemFileSet(dirName, lang, directory)
-	then, _ := time.Parse(time.RFC3339, q.LastAttempted)
-
-	return &domain.Problem{
-		Title:         q.Title,
-		Slug:          q.TitleSlug,
-		DirName:       dirName,
-		Difficulty:    q.Difficulty,
-		Status:        q.Status,
-		LastAttempted: then,
-		DirectoryPath: directory,
-		Language:      lang,
-		FileSet:       fileSet,
-	}
-}
-
-func (q *GetRandomWeightedRow) ToProblem(workspace, language string) *domain.Problem {
-	dirName := formatTitleSlug(q.TitleSlug)
-	lang := domain.NewProgrammingLanguage(language)
-	directory := domain.Path(filepath.Join(workspace, lang.Slug(), dirName))
-	fileSet := domain.NewProblemFileSet(dirName, lang, directory)
-	then, _ := time.Parse(time.RFC3339, q.LastAttempted)
-
-	var code string
-	var codeSnippets []domain.CodeSnippet
-	if err := json.Unmarshal([]byte(q.CodeSnippets), &codeSnippets); err != nil {
-		fmt.Println("Failed to unmarshal code snippets:", err)
-		return nil
-	}
-
-	for _, snippet := range codeSnippets {
-		if snippet.LangSlug == lang.TemplateName() {
-			code = snippet.Code
-			break
-		}
-	}
-
-	return &domain.Problem{
-		Title:         q.Title,
-		Slug:          q.TitleSlug,
-		FunctionName:  q.FunctionName,
-		Code:          code,
-		DirName:       dirName,
-		Difficulty:    q.Difficulty,
-		Status:        q.Status,
-		LastAttempted: then,
-		DirectoryPath: directory,
-		Language:      lang,
-		FileSet:       fileSet,
-	}
+	problem := newProblem(workspace, language, q.QuestionID, q.TitleSlug)
+	problem.Title = q.Title
+	problem.Difficulty = q.Difficulty
+	problem.Status = q.Status
+	problem.LastAttempted, _ = time.Parse(time.RFC3339, q.LastAttempted)
+	return problem
 }
 
 func ToRepoCreateParams(question *leetcode.Question) CreateParams {
@@ -235,5 +158,30 @@ func ToRepoCreateParams(question *leetcode.Question) CreateParams {
 		params.PaidOnly = 1
 	}
 
+	metadata, err := json.Marshal(question.Metadata)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to marshal metadata: %v\n", err)
+		params.Metadata = "{}"
+	} else {
+		params.Metadata = string(metadata)
+	}
+
 	return params
 }
+
+// ToRepoRefreshParams replaces every downloaded field of a stored question
+func ToRepoRefreshParams(question *leetcode.Question) RefreshParams {
+	params := ToRepoCreateParams(question)
+	return RefreshParams{
+		Title:        params.Title,
+		TitleSlug:    params.TitleSlug,
+		Difficulty:   params.Difficulty,
+		FunctionName: params.FunctionName,
+		Content:      params.Content,
+		CodeSnippets: params.CodeSnippets,
+		TestCases:    params.TestCases,
+		PaidOnly:     params.PaidOnly,
+		Metadata:     params.Metadata,
+		QuestionID:   params.QuestionID,
+	}
+}
@@ -0,0 +1,160 @@
+package repository
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/phantompunk/kata/internal/domain"
+)
+
+// Quiz statuses, relative to the filter's language
+const (
+	QuizUnsolved = "unsolved" // never accepted
+	QuizFailed   = "failed"   // rejected at least once and never accepted
+	QuizSolved   = "solved"
+)
+
+// QuizFilter narrows the problems a quiz picks from, zero values disable a filter
+type QuizFilter struct {
+	Language   string
+	Difficulty string
+	Tag        string
+	Status     string
+	NotSeenFor time.Duration
+	Limit      int
+}
+
+type QuizRow struct {
+	QuestionID    int64
+	Title         string
+	TitleSlug     string
+	Difficulty    string
+	CodeSnippets  string
+	FunctionName  string
+	Status        string
+	LastAttempted string
+	WeightScore   float64
+}
+
+// ListQuiz ranks downloaded problems by how much they need practice in the
+// filter's language, picking randomly between equally ranked problems
+func (q *Queries) ListQuiz(ctx context.Context, filter QuizFilter) ([]QuizRow, error) {
+	query, args := buildQuizQuery(filter, time.Now())
+	rows, err := q.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var items []QuizRow
+	for rows.Next() {
+		var i QuizRow
+		if err := rows.Scan(
+			&i.QuestionID,
+			&i.Title,
+			&i.TitleSlug,
+			&i.Difficulty,
+			&i.CodeSnippets,
+			&i.FunctionName,
+			&i.Status,
+			&i.LastAttempted,
+			&i.WeightScore,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+func buildQuizQuery(filter QuizFilter, now time.Time) (string, []any) {
+	args := []any{filter.Language, filter.Language}
+	var conditions []string
+
+	if filter.Difficulty != "" {
+		conditions = append(conditions, "q.difficulty = ? COLLATE NOCASE")
+		args = append(args, filter.Difficulty)
+	}
+
+	if filter.Tag != "" {
+		conditions = append(conditions, `EXISTS (
+    SELECT 1 FROM catalog c, json_each(c.tags)
+    WHERE c.question_id = q.question_id AND json_each.value = ?
+  )`)
+		args = append(args, NormalizeTag(filter.Tag))
+	}
+
+	switch filter.Status {
+	case QuizUnsolved:
+		conditions = append(conditions, "COALESCE(s.solved, 0) = 0")
+	case QuizFailed:
+		conditions = append(conditions, "COALESCE(s.solved, 0) = 0 AND COALESCE(a.failed_attempts, 0) > 0")
+	case QuizSolved:
+		conditions = append(conditions, "s.solved = 1")
+	}
+
+	if filter.NotSeenFor > 0 {
+		conditions = append(conditions, "COALESCE(a.last_seen, julianday(s.last_attempted), julianday(q.created_at)) <= julianday(?)")
+		args = append(args, now.Add(-filter.NotSeenFor).UTC().Format(time.RFC3339))
+	}
+
+	query := `SELECT q.question_id, q.title, q.title_slug, q.difficulty, q.code_snippets, q.function_name,
+  CASE WHEN s.solved = 1 THEN 'Completed' ELSE 'Attempted' END AS status,
+  COALESCE(s.last_attempted, q.created_at) AS last_attempted,
+  (
+    CAST(julianday('now') - julianday(COALESCE(s.last_attempted, q.created_at)) AS REAL) * 0.4 +
+    COALESCE(a.failed_attempts, 0) * 0.3 +
+    CASE q.difficulty WHEN 'Easy' THEN 0.3 WHEN 'Medium' THEN 0.6 ELSE 1.0 END * 0.2 +
+    CASE WHEN COALESCE(a.times_solved, 0) = 1 THEN 0.1 ELSE 0.0 END
+  ) AS weight_score
+FROM questions q
+LEFT JOIN submissions s ON s.question_id = q.question_id AND s.lang_slug = ?
+LEFT JOIN (
+  SELECT question_id,
+    COUNT(CASE WHEN kind = 'submit' AND verdict <> 'Accepted' THEN 1 END) AS failed_attempts,
+    COUNT(CASE WHEN kind = 'submit' AND verdict = 'Accepted' THEN 1 END) AS times_solved,
+    MAX(julianday(created_at)) AS last_seen
+  FROM attempts
+  WHERE lang_slug = ?
+  GROUP BY question_id
+) a ON a.question_id = q.question_id`
+
+	if len(conditions) > 0 {
+		query += "\nWHERE " + strings.Join(conditions, " AND ")
+	}
+	query += "\nORDER BY weight_score DESC, RANDOM()"
+
+	if filter.Limit > 0 {
+		query += "\nLIMIT ?"
+		args = append(args, filter.Limit)
+	}
+
+	return query, args
+}
+
+func (q *QuizRow) ToProblem(workspace, language string) (*domain.Problem, error) {
+	problem := newProblem(workspace, language, q.QuestionID, q.TitleSlug)
+
+	code, err := snippetFor(q.CodeSnippets, problem.Language)
+	if err != nil {
+		return nil, err
+	}
+
+	problem.Title = q.Title
+	problem.FunctionName = q.FunctionName
+	problem.Code = code
+	problem.Difficulty = q.Difficulty
+	problem.Status = q.Status
+	problem.LastAttempted, _ = time.Parse(time.RFC3339, q.LastAttempted)
+	return problem, nil
+}
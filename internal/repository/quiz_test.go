@@ -0,0 +1,75 @@
+package repository
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/phantompunk/kata/pkg/assert"
+)
+
+// whereClause returns the filter conditions of a generated query
+func whereClause(query string) string {
+	_, where, found := strings.Cut(query, "\nWHERE ")
+	if !found {
+		return ""
+	}
+	where, _, _ = strings.Cut(where, "\nORDER BY")
+	return where
+}
+
+func TestBuildQuizQuery(t *testing.T) {
+	now := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		filter QuizFilter
+		where  string
+		args   []any
+		limit  bool
+	}{
+		{"No filters", QuizFilter{Language: "go"}, "", []any{"go", "go"}, false},
+		{"Difficulty", QuizFilter{Language: "go", Difficulty: "medium"}, "q.difficulty = ? COLLATE NOCASE", []any{"go", "go", "medium"}, false},
+		{"Tag is normalized", QuizFilter{Language: "python", Tag: "Dynamic Programming"}, "c.question_id = q.question_id AND json_each.value = ?", []any{"python", "python", "dynamic-programming"}, false},
+		{"Unsolved", QuizFilter{Language: "go", Status: QuizUnsolved}, "COALESCE(s.solved, 0) = 0", []any{"go", "go"}, false},
+		{"Failed", QuizFilter{Language: "go", Status: QuizFailed}, "COALESCE(s.solved, 0) = 0 AND COALESCE(a.failed_attempts, 0) > 0", []any{"go", "go"}, false},
+		{"Solved", QuizFilter{Language: "go", Status: QuizSolved}, "s.solved = 1", []any{"go", "go"}, false},
+		{"Not seen for", QuizFilter{Language: "go", NotSeenFor: 14 * 24 * time.Hour}, "julianday(q.created_at)) <= julianday(?)", []any{"go", "go", "2026-10-03T12:00:00Z"}, false},
+		{"Limit", QuizFilter{Language: "go", Limit: 3}, "", []any{"go", "go", 3}, true},
+		{
+			"Combined",
+			QuizFilter{Language: "rust", Difficulty: "hard", Tag: "graph", Status: QuizSolved, Limit: 2},
+			"q.difficulty = ? COLLATE NOCASE AND EXISTS",
+			[]any{"rust", "rust", "hard", "graph", 2},
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query, args := buildQuizQuery(tt.filter, now)
+
+			assert.Equal(t, fmt.Sprint(args), fmt.Sprint(tt.args))
+			assert.Equal(t, strings.Count(query, "?"), len(args))
+			assert.True(t, strings.Contains(whereClause(query), tt.where))
+			assert.Equal(t, whereClause(query) == "", tt.where == "")
+			assert.Equal(t, strings.HasSuffix(query, "\nLIMIT ?"), tt.limit)
+		})
+	}
+
+	t.Run("Language filters submissions and attempts", func(t *testing.T) {
+		query, args := buildQuizQuery(QuizFilter{Language: "python"}, now)
+
+		assert.True(t, strings.Contains(query, "LEFT JOIN submissions s ON s.question_id = q.question_id AND s.lang_slug = ?"))
+		assert.True(t, strings.Contains(query, "FROM attempts\n  WHERE lang_slug = ?"))
+		assert.False(t, strings.Contains(query, "python"))
+		assert.Equal(t, args[0], any("python"))
+		assert.Equal(t, args[1], any("python"))
+	})
+
+	t.Run("Status combined with other filters", func(t *testing.T) {
+		query, _ := buildQuizQuery(QuizFilter{Language: "go", Difficulty: "easy", Status: QuizFailed}, now)
+		assert.Equal(t, whereClause(query), "q.difficulty = ? COLLATE NOCASE AND COALESCE(s.solved, 0) = 0 AND COALESCE(a.failed_attempts, 0) > 0")
+	})
+}
@@ -0,0 +1,50 @@
+package repository
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/phantompunk/kata/internal/domain"
+	"github.com/phantompunk/kata/internal/srs"
+)
+
+func (r Review) ToCard() srs.Card {
+	return srs.Card{
+		Ease:        r.Ease,
+		Interval:    int(r.IntervalDays),
+		Repetitions: int(r.Repetitions),
+		Due:         parseDate(r.Due),
+		LastReview:  parseTimestamp(r.LastReviewed),
+	}
+}
+
+func ToSaveReviewParams(questionID int64, langSlug string, card srs.Card) SaveReviewParams {
+	return SaveReviewParams{
+		QuestionID:   questionID,
+		LangSlug:     langSlug,
+		Ease:         card.Ease,
+		IntervalDays: int64(card.Interval),
+		Repetitions:  int64(card.Repetitions),
+		Due:          card.Due.Format(time.DateOnly),
+		LastReviewed: card.LastReview.Format(time.RFC3339),
+	}
+}
+
+func (r ListDueReviewsRow) ToReview() domain.Review {
+	return domain.Review{
+		ID:           strconv.FormatInt(r.QuestionID, 10),
+		Title:        r.Title,
+		Slug:         r.TitleSlug,
+		Difficulty:   r.Difficulty,
+		Language:     r.LangSlug,
+		Interval:     int(r.IntervalDays),
+		Due:          parseDate(r.Due),
+		LastReviewed: parseTimestamp(r.LastReviewed),
+	}
+}
+
+// parseDate reads a due date as midnight local time, the day reviews become due
+func parseDate(value string) time.Time {
+	t, _ := time.ParseInLocation(time.DateOnly, value, time.Local)
+	return t
+}
@@ -0,0 +1,7 @@
+package repository
+
+import "github.com/phantompunk/kata/internal/domain"
+
+func (c CustomTestcase) ToTestcase() domain.Testcase {
+	return domain.Testcase{Input: c.Input, Expected: c.Expected}
+}
@@ -0,0 +1,136 @@
+package runner
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"regexp"
+	"strings"
+)
+
+// goTestEvent mirrors the events emitted by `go test -json`
+type goTestEvent struct {
+	Action string `json:"Action"`
+	Test   string `json:"Test"`
+	Output string `json:"Output"`
+}
+
+func parseGoTest(stdout, stderr []byte) ([]CaseResult, string) {
+	var order []string
+	results := make(map[string]*CaseResult)
+	var output strings.Builder
+
+	scanner := bufio.NewScanner(bytes.NewReader(stdout))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := scanner.Bytes()
+
+		var event goTestEvent
+		if err := json.Unmarshal(line, &event); err != nil {
+			output.Write(line)
+			output.WriteString("\n")
+			continue
+		}
+		output.WriteString(event.Output)
+
+		if event.Test == "" {
+			continue
+		}
+
+		result, ok := results[event.Test]
+		if !ok {
+			result = &CaseResult{Name: event.Test}
+			results[event.Test] = result
+			order = append(order, event.Test)
+		}
+
+		switch event.Action {
+		case "output":
+			result.Output += event.Output
+		case "pass":
+			result.Passed = true
+		case "fail":
+			result.Passed = false
+		}
+	}
+	output.Write(stderr)
+
+	// Only report leaf tests, parents of subtests are summaries
+	var cases []CaseResult
+	for _, name := range order {
+		if hasSubtests(name, order) {
+			continue
+		}
+		cases = append(cases, *results[name])
+	}
+	return cases, output.String()
+}
+
+func hasSubtests(name string, names []string) bool {
+	for _, other := range names {
+		if strings.HasPrefix(other, name+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+var pytestLine = regexp.MustCompile(`^(\S+::\S+)\s+(PASSED|FAILED|ERROR)`)
+
+func parsePytest(stdout, stderr []byte) ([]CaseResult, string) {
+	var cases []CaseResult
+
+	scanner := bufio.NewScanner(bytes.NewReader(stdout))
+	for scanner.Scan() {
+		match := pytestLine.FindStringSubmatch(scanner.Text())
+		if match == nil {
+			continue
+		}
+
+		name := match[1]
+		if idx := strings.Index(name, "::"); idx >= 0 {
+			name = name[idx+2:]
+		}
+		cases = append(cases, CaseResult{Name: name, Passed: match[2] == "PASSED"})
+	}
+
+	return cases, combineOutput(stdout, stderr)
+}
+
+// jestReport mirrors the subset of `jest --json` output used by kata
+type jestReport struct {
+	TestResults []struct {
+		Message          string `json:"message"`
+		AssertionResults []struct {
+			FullName        string   `json:"fullName"`
+			Status          string   `json:"status"`
+			FailureMessages []string `json:"failureMessages"`
+		} `json:"assertionResults"`
+	} `json:"testResults"`
+}
+
+func parseJest(stdout, stderr []byte) ([]CaseResult, string) {
+	var report jestReport
+	if err := json.Unmarshal(stdout, &report); err != nil {
+		return nil, combineOutput(stdout, stderr)
+	}
+
+	var cases []CaseResult
+	var output strings.Builder
+	for _, suite := range report.TestResults {
+		output.WriteString(suite.Message)
+		for _, assertion := range suite.AssertionResults {
+			if assertion.Status == "pending" || assertion.Status == "skipped" {
+				continue
+			}
+			cases = append(cases, CaseResult{
+				Name:   assertion.FullName,
+				Passed: assertion.Status == "passed",
+				Output: strings.Join(assertion.FailureMessages, "\n"),
+			})
+		}
+	}
+	output.Write(stderr)
+
+	return cases, output.String()
+}
@@ -0,0 +1,48 @@
+package runner
+
+import (
+	"testing"
+
+	"github.com/phantompunk/kata/pkg/assert"
+)
+
+func TestParseGoTest(t *testing.T) {
+	stdout := `{"Action":"run","Test":"TestTwoSum"}
+{"Action":"run","Test":"TestTwoSum/example_1"}
+{"Action":"output","Test":"TestTwoSum/example_1","Output":"    two_sum_test.go:20: got [] want [0 1]\n"}
+{"Action":"fail","Test":"TestTwoSum/example_1"}
+{"Action":"run","Test":"TestTwoSum/example_2"}
+{"Action":"pass","Test":"TestTwoSum/example_2"}
+{"Action":"fail","Test":"TestTwoSum"}
+{"Action":"fail"}`
+
+	cases, _ := parseGoTest([]byte(stdout), nil)
+
+	assert.Equal(t, len(cases), 2)
+	assert.Equal(t, cases[0].Name, "TestTwoSum/example_1")
+	assert.False(t, cases[0].Passed)
+	assert.Equal(t, cases[0].Output, "    two_sum_test.go:20: got [] want [0 1]\n")
+	assert.True(t, cases[1].Passed)
+}
+
+func TestParsePytest(t *testing.T) {
+	stdout := `two_sum_test.py::TestTwoSum::test_example_1 PASSED    [ 50%]
+two_sum_test.py::TestTwoSum::test_example_2 FAILED    [100%]`
+
+	cases, _ := parsePytest([]byte(stdout), nil)
+
+	assert.Equal(t, len(cases), 2)
+	assert.Equal(t, cases[0].Name, "TestTwoSum::test_example_1")
+	assert.True(t, cases[0].Passed)
+	assert.False(t, cases[1].Passed)
+}
+
+func TestParseJest(t *testing.T) {
+	stdout := `{"testResults":[{"message":"","assertionResults":[{"fullName":"twoSum example 1","status":"passed","failureMessages":[]},{"fullName":"twoSum example 2","status":"failed","failureMessages":["Expected: [0,1]"]}]}]}`
+
+	cases, _ := parseJest([]byte(stdout), nil)
+
+	assert.Equal(t, len(cases), 2)
+	assert.True(t, cases[0].Passed)
+	assert.Equal(t, cases[1].Output, "Expected: [0,1]")
+}
@@ -0,0 +1,171 @@
+package runner
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"github.com/phantompunk/kata/internal/domain"
+)
+
+var (
+	ErrUnsupportedLanguage = errors.New("local testing is not supported for this language")
+	ErrToolchainNotFound   = errors.New("language toolchain not found")
+	ErrTestFileNotFound    = errors.New("test file not found")
+	ErrBuildFailed         = errors.New("tests failed to build")
+)
+
+type Runner interface {
+	Run(ctx context.Context, problem *domain.Problem) (*Result, error)
+}
+
+// LocalRunner executes a problem's generated test file with the language's own toolchain
+type LocalRunner struct {
+	lookPath func(file string) (string, error)
+}
+
+func New() *LocalRunner {
+	return &LocalRunner{lookPath: exec.LookPath}
+}
+
+// Result holds the outcome of a local test run
+type Result struct {
+	Cases  []CaseResult
+	Output string
+}
+
+// CaseResult holds the outcome of a single test case
+type CaseResult struct {
+	Name   string
+	Passed bool
+	Output string
+}
+
+func (r *Result) PassedCount() int {
+	count := 0
+	for _, c := range r.Cases {
+		if c.Passed {
+			count++
+		}
+	}
+	return count
+}
+
+func (r *Result) FailedCount() int { return len(r.Cases) - r.PassedCount() }
+func (r *Result) AllPassed() bool  { return len(r.Cases) > 0 && r.FailedCount() == 0 }
+
+// command describes how to invoke a toolchain and parse its output
+type command struct {
+	name  string
+	args  []string
+	env   []string
+	parse func(stdout, stderr []byte) ([]CaseResult, string)
+}
+
+func (r *LocalRunner) Run(ctx context.Context, problem *domain.Problem) (*Result, error) {
+	if !problem.TestExists() {
+		return nil, fmt.Errorf("%w: %s", ErrTestFileNotFound, problem.TestPath())
+	}
+
+	spec, err := resolveCommand(problem)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := r.lookPath(spec.name); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrToolchainNotFound, spec.name)
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, spec.name, spec.args...)
+	cmd.Dir = problem.DirectoryPath.String()
+	cmd.Env = append(os.Environ(), spec.env...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	runErr := cmd.Run()
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	cases, output := spec.parse(stdout.Bytes(), stderr.Bytes())
+	result := &Result{Cases: cases, Output: output}
+
+	if runErr != nil && len(result.Cases) == 0 {
+		var exitErr *exec.ExitError
+		if errors.As(runErr, &exitErr) {
+			return result, ErrBuildFailed
+		}
+		return result, fmt.Errorf("running %s: %w", spec.name, runErr)
+	}
+
+	return result, nil
+}
+
+// parsers read the output of the test frameworks a language's test command can use
+var parsers = map[string]func(stdout, stderr []byte) ([]CaseResult, string){
+	"gotest": parseGoTest,
+	"pytest": parsePytest,
+	"jest":   parseJest,
+}
+
+// filesArg in a test command expands to the test file and, when generated, the harness
+const filesArg = "{files}"
+
+func resolveCommand(problem *domain.Problem) (command, error) {
+	spec := problem.Language.TestCommand()
+	parse, ok := parsers[spec.Parser]
+	if len(spec.Command) == 0 || !ok {
+		return command{}, fmt.Errorf("%w: %s", ErrUnsupportedLanguage, problem.Language.DisplayName())
+	}
+
+	testFiles := []string{filepath.Base(problem.TestPath())}
+	if harness, ok := problem.HarnessPath(); ok && domain.Path(harness).Exists() {
+		testFiles = append(testFiles, filepath.Base(harness))
+	}
+
+	var args []string
+	for _, arg := range spec.Command[1:] {
+		if arg == filesArg {
+			args = append(args, testFiles...)
+			continue
+		}
+		args = append(args, arg)
+	}
+
+	cmd := command{name: spec.Command[0], args: args, parse: parse}
+	if spec.Parser == "gotest" {
+		cmd.env = goEnv(problem.DirectoryPath.String())
+	}
+	return cmd, nil
+}
+
+// goEnv disables module mode when the problem directory is not part of a Go module,
+// allowing the generated package to be tested on its own
+func goEnv(dir string) []string {
+	for d := dir; ; d = filepath.Dir(d) {
+		if _, err := os.Stat(filepath.Join(d, "go.mod")); err == nil {
+			return nil
+		}
+		if parent := filepath.Dir(d); parent == d {
+			break
+		}
+	}
+	return []string{"GO111MODULE=off"}
+}
+
+func combineOutput(stdout, stderr []byte) string {
+	var buf bytes.Buffer
+	buf.Write(stdout)
+	if len(stderr) > 0 {
+		if buf.Len() > 0 {
+			buf.WriteString("\n")
+		}
+		buf.Write(stderr)
+	}
+	return buf.String()
+}
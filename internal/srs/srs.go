@@ -0,0 +1,102 @@
+// Package srs schedules problem reviews with the SM-2 spaced repetition algorithm
+package srs
+
+import (
+	"errors"
+	"math"
+	"strings"
+	"time"
+)
+
+const (
+	DefaultEase = 2.5
+	MinEase     = 1.3
+)
+
+var ErrInvalidGrade = errors.New("grade must be one of again, hard, good or easy")
+
+// Grade is how well a problem was recalled, on SM-2's zero to five scale
+type Grade int
+
+const (
+	// Again is given to rejected submissions
+	Again Grade = 1
+	Hard  Grade = 3
+	Good  Grade = 4
+	Easy  Grade = 5
+)
+
+var gradeNames = map[Grade]string{
+	Again: "again",
+	Hard:  "hard",
+	Good:  "good",
+	Easy:  "easy",
+}
+
+func (g Grade) String() string {
+	return gradeNames[g]
+}
+
+// ParseGrade accepts a grade name or its initial
+func ParseGrade(s string) (Grade, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	for grade, name := range gradeNames {
+		if s == name || s == name[:1] {
+			return grade, nil
+		}
+	}
+	return 0, ErrInvalidGrade
+}
+
+// Card is the review state of one problem in one language
+type Card struct {
+	Ease        float64
+	Interval    int // days until the next review
+	Repetitions int // successful reviews in a row
+	Due         time.Time
+	LastReview  time.Time
+}
+
+// NewCard returns the state of a problem that was never reviewed
+func NewCard() Card {
+	return Card{Ease: DefaultEase}
+}
+
+// Review returns the card rescheduled after a review with the given grade.
+// Failed recalls start over with a one day interval, successful ones grow
+// the interval by the ease factor.
+func (c Card) Review(grade Grade, now time.Time) Card {
+	if c.Ease == 0 {
+		c.Ease = DefaultEase
+	}
+
+	if grade < Hard {
+		c.Repetitions = 0
+		c.Interval = 1
+	} else {
+		switch c.Repetitions {
+		case 0:
+			c.Interval = 1
+		case 1:
+			c.Interval = 6
+		default:
+			c.Interval = int(math.Round(float64(c.Interval) * c.Ease))
+		}
+		c.Repetitions++
+	}
+
+	q := float64(5 - grade)
+	c.Ease = max(MinEase, c.Ease+0.1-q*(0.08+q*0.02))
+	c.LastReview = now
+	c.Due = startOfDay(now).AddDate(0, 0, c.Interval)
+	return c
+}
+
+// IsDue reports whether the card should be reviewed on the given day
+func (c Card) IsDue(now time.Time) bool {
+	return !c.Due.After(startOfDay(now))
+}
+
+func startOfDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
@@ -0,0 +1,81 @@
+package srs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/phantompunk/kata/pkg/assert"
+)
+
+func TestReview(t *testing.T) {
+	day := time.Date(2025, 3, 10, 18, 30, 0, 0, time.UTC)
+
+	t.Run("good reviews grow the interval", func(t *testing.T) {
+		card := NewCard()
+		var intervals []int
+		for range 4 {
+			card = card.Review(Good, day)
+			intervals = append(intervals, card.Interval)
+		}
+
+		assert.Equal(t, len(intervals), 4)
+		assert.Equal(t, intervals[0], 1)
+		assert.Equal(t, intervals[1], 6)
+		assert.Equal(t, intervals[2], 15)
+		assert.Equal(t, intervals[3], 38)
+		assert.Equal(t, card.Ease, DefaultEase)
+		assert.Equal(t, card.Repetitions, 4)
+	})
+
+	t.Run("again resets repetitions and lowers ease", func(t *testing.T) {
+		card := NewCard().Review(Good, day).Review(Good, day)
+		card = card.Review(Again, day)
+
+		assert.Equal(t, card.Interval, 1)
+		assert.Equal(t, card.Repetitions, 0)
+		assert.True(t, card.Ease < DefaultEase)
+	})
+
+	t.Run("ease never drops below the minimum", func(t *testing.T) {
+		card := NewCard()
+		for range 10 {
+			card = card.Review(Again, day)
+		}
+		assert.Equal(t, card.Ease, MinEase)
+	})
+
+	t.Run("easy raises ease", func(t *testing.T) {
+		card := NewCard().Review(Easy, day)
+		assert.True(t, card.Ease > DefaultEase)
+	})
+
+	t.Run("due at the start of the day", func(t *testing.T) {
+		card := NewCard().Review(Good, day)
+		assert.Equal(t, card.Due, time.Date(2025, 3, 11, 0, 0, 0, 0, time.UTC))
+		assert.False(t, card.IsDue(day))
+		assert.True(t, card.IsDue(day.AddDate(0, 0, 1)))
+	})
+}
+
+func TestParseGrade(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected Grade
+		valid    bool
+	}{
+		{"good", Good, true},
+		{" Easy ", Easy, true},
+		{"h", Hard, true},
+		{"a", Again, true},
+		{"great", 0, false},
+		{"", 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			grade, err := ParseGrade(tt.input)
+			assert.Equal(t, grade, tt.expected)
+			assert.Equal(t, err == nil, tt.valid)
+		})
+	}
+}
@@ -0,0 +1,386 @@
+package table
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/spinner"
+	"github.com/charmbracelet/bubbles/table"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/phantompunk/kata/internal/domain"
+)
+
+const browserHelp = "↑/↓ move • / filter • e edit • t test • s submit • r refresh • pgup/pgdn scroll • q quit"
+
+// Verdict is the outcome of a test run or submission started from the browser
+type Verdict struct {
+	// Summary fits on the status line, Details replaces the preview pane
+	Summary string
+	Details string
+}
+
+// Workspace performs the problem actions offered by the browser
+type Workspace interface {
+	List(ctx context.Context) ([]domain.QuestionStat, error)
+	Statement(ctx context.Context, slug string) (string, error)
+	Edit(ctx context.Context, slug string) (*exec.Cmd, error)
+	Test(ctx context.Context, slug string) (Verdict, error)
+	Submit(ctx context.Context, slug string) (Verdict, error)
+	Refresh(ctx context.Context, slug string) error
+}
+
+type statementMsg struct {
+	slug string
+	text string
+	err  error
+}
+
+type verdictMsg struct {
+	slug    string
+	verdict Verdict
+	err     error
+}
+
+type reloadMsg struct {
+	questions []domain.QuestionStat
+	status    string
+	err       error
+}
+
+type editorMsg struct {
+	err error
+}
+
+type browser struct {
+	ctx       context.Context
+	workspace Workspace
+	config    *TableConfig
+	tracks    []string
+
+	all     []domain.QuestionStat
+	visible []domain.QuestionStat
+
+	table   table.Model
+	preview viewport.Model
+	spinner spinner.Model
+
+	filter    string
+	filtering bool
+	// busy describes the running test, submit or refresh, only one runs at a time
+	busy      string
+	status    string
+	previewed string
+	height    int
+}
+
+// Browse runs the interactive workspace browser until the user quits
+func Browse(ctx context.Context, workspace Workspace, questions []domain.QuestionStat, tracks []string, config *TableConfig) error {
+	if err := validateInputs(questions, tracks, config); err != nil {
+		return err
+	}
+
+	m := &browser{
+		ctx:       ctx,
+		workspace: workspace,
+		config:    config,
+		tracks:    tracks,
+		all:       questions,
+		table:     createTable(createColumns(tracks, config), nil, config),
+		preview:   viewport.New(80, 10),
+		spinner:   spinner.New(spinner.WithSpinner(spinner.Dot)),
+	}
+	m.applyFilter()
+
+	if _, err := tea.NewProgram(m, tea.WithAltScreen(), tea.WithContext(ctx)).Run(); err != nil {
+		return fmt.Errorf("running browser: %w", err)
+	}
+	return nil
+}
+
+func (m *browser) Init() tea.Cmd {
+	return m.loadStatement()
+}
+
+func (m *browser) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.preview.Width = msg.Width - 2
+		m.height = msg.Height
+		m.resize()
+		// Wrap the statement again for the new width
+		m.previewed = ""
+		return m, m.loadStatement()
+
+	case tea.KeyMsg:
+		if m.filtering {
+			return m, m.updateFilter(msg)
+		}
+		return m, m.handleKey(msg)
+
+	case spinner.TickMsg:
+		if m.busy == "" {
+			return m, nil
+		}
+		var cmd tea.Cmd
+		m.spinner, cmd = m.spinner.Update(msg)
+		return m, cmd
+
+	case statementMsg:
+		if question, ok := m.selected(); !ok || question.Slug != msg.slug {
+			return m, nil
+		}
+		text := msg.text
+		if msg.err != nil {
+			text = fmt.Sprintf("Could not load %s: %v", msg.slug, msg.err)
+		}
+		m.showPreview(msg.slug, text)
+		return m, nil
+
+	case verdictMsg:
+		m.busy = ""
+		if msg.err != nil {
+			m.status = "✘ " + msg.err.Error()
+			return m, nil
+		}
+		m.status = msg.verdict.Summary
+		if msg.verdict.Details != "" {
+			m.showPreview(msg.slug, msg.verdict.Details)
+		}
+		return m, m.reload("")
+
+	case reloadMsg:
+		m.busy = ""
+		if msg.err != nil {
+			m.status = "✘ " + msg.err.Error()
+			return m, nil
+		}
+		m.all = msg.questions
+		m.applyFilter()
+		if msg.status != "" {
+			m.status = msg.status
+			m.previewed = ""
+			return m, m.loadStatement()
+		}
+		return m, nil
+
+	case editorMsg:
+		if msg.err != nil {
+			m.status = "✘ editor: " + msg.err.Error()
+		}
+		return m, nil
+	}
+
+	return m, nil
+}
+
+func (m *browser) handleKey(msg tea.KeyMsg) tea.Cmd {
+	switch msg.String() {
+	case "q", "ctrl+c":
+		return tea.Quit
+	case "esc":
+		if m.filter == "" {
+			return tea.Quit
+		}
+		m.filter = ""
+		m.applyFilter()
+		return m.loadStatement()
+	case "/":
+		m.filtering = true
+		return nil
+	case "pgdown", "ctrl+d":
+		m.preview.HalfViewDown()
+		return nil
+	case "pgup", "ctrl+u":
+		m.preview.HalfViewUp()
+		return nil
+	case "e":
+		return m.edit()
+	case "t":
+		return m.run("Testing", m.workspace.Test)
+	case "s":
+		return m.run("Submitting", m.workspace.Submit)
+	case "r":
+		return m.refresh()
+	}
+
+	var cmd tea.Cmd
+	m.table, cmd = m.table.Update(msg)
+	return tea.Batch(cmd, m.loadStatement())
+}
+
+// updateFilter edits the filter text, enter keeps the filter and esc drops it
+func (m *browser) updateFilter(msg tea.KeyMsg) tea.Cmd {
+	switch msg.Type {
+	case tea.KeyEnter:
+		m.filtering = false
+		return nil
+	case tea.KeyEsc:
+		m.filtering = false
+		m.filter = ""
+	case tea.KeyBackspace:
+		if runes := []rune(m.filter); len(runes) > 0 {
+			m.filter = string(runes[:len(runes)-1])
+		}
+	case tea.KeySpace:
+		m.filter += " "
+	case tea.KeyRunes:
+		m.filter += string(msg.Runes)
+	case tea.KeyCtrlC:
+		return tea.Quit
+	default:
+		return nil
+	}
+
+	m.applyFilter()
+	return m.loadStatement()
+}
+
+// applyFilter keeps the problems whose id, title or slug contain the filter
+func (m *browser) applyFilter() {
+	query := strings.ToLower(strings.TrimSpace(m.filter))
+	m.visible = m.visible[:0]
+	for _, question := range m.all {
+		if query == "" ||
+			question.ID == query ||
+			strings.Contains(strings.ToLower(question.Title), query) ||
+			strings.Contains(question.Slug, query) {
+			m.visible = append(m.visible, question)
+		}
+	}
+
+	m.table.SetRows(createRows(m.visible, m.tracks, m.config))
+	if m.table.Cursor() >= len(m.visible) {
+		m.table.SetCursor(max(len(m.visible)-1, 0))
+	}
+	m.resize()
+}
+
+// resize gives the preview pane whatever height the table leaves free
+func (m *browser) resize() {
+	m.table.SetHeight(calculateTableHeight(len(m.visible), m.config.MaxHeight))
+	if m.height == 0 {
+		return
+	}
+	// Borders around both panes, the status line and the help line
+	used := lipgloss.Height(m.table.View()) + 6
+	m.preview.Height = max(m.height-used, domain.MinListHeight)
+}
+
+func (m *browser) selected() (domain.QuestionStat, bool) {
+	cursor := m.table.Cursor()
+	if cursor < 0 || cursor >= len(m.visible) {
+		return domain.QuestionStat{}, false
+	}
+	return m.visible[cursor], true
+}
+
+func (m *browser) showPreview(slug, text string) {
+	m.previewed = slug
+	m.preview.SetContent(lipgloss.NewStyle().Width(m.preview.Width).Render(text))
+	m.preview.GotoTop()
+}
+
+// loadStatement reads the selected problem's statement unless it is already shown
+func (m *browser) loadStatement() tea.Cmd {
+	question, ok := m.selected()
+	if !ok {
+		m.previewed = ""
+		m.preview.SetContent("No problems match the filter")
+		return nil
+	}
+	if question.Slug == m.previewed {
+		return nil
+	}
+
+	ctx, workspace, slug := m.ctx, m.workspace, question.Slug
+	return func() tea.Msg {
+		text, err := workspace.Statement(ctx, slug)
+		return statementMsg{slug: slug, text: text, err: err}
+	}
+}
+
+func (m *browser) edit() tea.Cmd {
+	question, ok := m.selected()
+	if !ok {
+		return nil
+	}
+
+	cmd, err := m.workspace.Edit(m.ctx, question.Slug)
+	if err != nil {
+		m.status = "✘ " + err.Error()
+		return nil
+	}
+	return tea.ExecProcess(cmd, func(err error) tea.Msg {
+		return editorMsg{err: err}
+	})
+}
+
+// run starts a test or submission for the selected problem in the background
+func (m *browser) run(label string, action func(context.Context, string) (Verdict, error)) tea.Cmd {
+	question, ok := m.selected()
+	if !ok || m.busy != "" {
+		return nil
+	}
+
+	m.busy = fmt.Sprintf("%s %s", label, question.Slug)
+	ctx, slug := m.ctx, question.Slug
+	return tea.Batch(m.spinner.Tick, func() tea.Msg {
+		verdict, err := action(ctx, slug)
+		return verdictMsg{slug: slug, verdict: verdict, err: err}
+	})
+}
+
+// refresh downloads the selected problem again and reloads the list
+func (m *browser) refresh() tea.Cmd {
+	question, ok := m.selected()
+	if !ok || m.busy != "" {
+		return nil
+	}
+
+	m.busy = fmt.Sprintf("Refreshing %s", question.Slug)
+	ctx, workspace, slug := m.ctx, m.workspace, question.Slug
+	reload := m.reload(fmt.Sprintf("✔ Refreshed %s from LeetCode", slug))
+	return tea.Batch(m.spinner.Tick, func() tea.Msg {
+		if err := workspace.Refresh(ctx, slug); err != nil {
+			return reloadMsg{err: err}
+		}
+		return reload()
+	})
+}
+
+func (m *browser) reload(status string) tea.Cmd {
+	ctx, workspace := m.ctx, m.workspace
+	return func() tea.Msg {
+		questions, err := workspace.List(ctx)
+		return reloadMsg{questions: questions, status: status, err: err}
+	}
+}
+
+func (m *browser) View() string {
+	var line string
+	switch {
+	case m.filtering:
+		line = "/" + m.filter + "█"
+	case m.busy != "":
+		line = fmt.Sprintf("%s %s...", m.spinner.View(), m.busy)
+	case m.filter != "":
+		line = fmt.Sprintf("filter: %s (%d of %d)", m.filter, len(m.visible), len(m.all))
+		if m.status != "" {
+			line += " • " + m.status
+		}
+	default:
+		line = m.status
+	}
+
+	help := lipgloss.NewStyle().Faint(true).Render(browserHelp)
+	return lipgloss.JoinVertical(lipgloss.Left,
+		m.config.Styles.Base.Render(m.table.View()),
+		line,
+		m.config.Styles.Base.Render(m.preview.View()),
+		help,
+	)
+}
@@ -0,0 +1,114 @@
+package table
+
+import (
+	"context"
+	"os/exec"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/phantompunk/kata/internal/domain"
+	"github.com/phantompunk/kata/pkg/assert"
+)
+
+type fakeWorkspace struct {
+	questions []domain.QuestionStat
+}
+
+func (f *fakeWorkspace) List(ctx context.Context) ([]domain.QuestionStat, error) {
+	return f.questions, nil
+}
+
+func (f *fakeWorkspace) Statement(ctx context.Context, slug string) (string, error) {
+	return "statement of " + slug, nil
+}
+
+func (f *fakeWorkspace) Edit(ctx context.Context, slug string) (*exec.Cmd, error) {
+	return exec.Command("true"), nil
+}
+
+func (f *fakeWorkspace) Test(ctx context.Context, slug string) (Verdict, error) {
+	return Verdict{Summary: "✔ All test cases passed", Details: "Case 1 passed"}, nil
+}
+
+func (f *fakeWorkspace) Submit(ctx context.Context, slug string) (Verdict, error) {
+	return Verdict{Summary: "✔ Accepted"}, nil
+}
+
+func (f *fakeWorkspace) Refresh(ctx context.Context, slug string) error {
+	return nil
+}
+
+func newTestBrowser() (*browser, *fakeWorkspace) {
+	workspace := &fakeWorkspace{questions: []domain.QuestionStat{
+		{ID: "1", Title: "Two Sum", Slug: "two-sum", Difficulty: "Easy"},
+		{ID: "226", Title: "Invert Binary Tree", Slug: "invert-binary-tree", Difficulty: "Easy"},
+		{ID: "257", Title: "Binary Tree Paths", Slug: "binary-tree-paths", Difficulty: "Easy"},
+	}}
+
+	config := DefaultConfig()
+	m := &browser{
+		ctx:       context.Background(),
+		workspace: workspace,
+		config:    config,
+		tracks:    []string{"go"},
+		all:       workspace.questions,
+		table:     createTable(createColumns([]string{"go"}, config), nil, config),
+	}
+	m.applyFilter()
+	return m, workspace
+}
+
+func typeKeys(m *browser, keys string) {
+	for _, r := range keys {
+		m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+}
+
+func TestBrowserFilter(t *testing.T) {
+	m, _ := newTestBrowser()
+
+	typeKeys(m, "/tree")
+	assert.True(t, m.filtering)
+	assert.Equal(t, m.filter, "tree")
+	assert.Equal(t, len(m.visible), 2)
+
+	m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	assert.False(t, m.filtering)
+	assert.Equal(t, len(m.visible), 2)
+
+	m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	assert.Equal(t, m.filter, "")
+	assert.Equal(t, len(m.visible), 3)
+}
+
+func TestBrowserFilterByID(t *testing.T) {
+	m, _ := newTestBrowser()
+
+	typeKeys(m, "/226")
+	assert.Equal(t, len(m.visible), 1)
+	assert.Equal(t, m.visible[0].Slug, "invert-binary-tree")
+}
+
+func TestBrowserTestShowsVerdict(t *testing.T) {
+	m, _ := newTestBrowser()
+
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'t'}})
+	assert.True(t, strings.HasPrefix(m.busy, "Testing two-sum"))
+	assert.NotNil(t, cmd)
+
+	m.Update(verdictMsg{slug: "two-sum", verdict: Verdict{Summary: "✔ All test cases passed", Details: "Case 1 passed"}})
+	assert.Equal(t, m.busy, "")
+	assert.Equal(t, m.status, "✔ All test cases passed")
+	assert.Equal(t, m.previewed, "two-sum")
+}
+
+func TestBrowserIgnoresStaleStatement(t *testing.T) {
+	m, _ := newTestBrowser()
+
+	m.Update(statementMsg{slug: "binary-tree-paths", text: "stale"})
+	assert.Equal(t, m.previewed, "")
+
+	m.Update(statementMsg{slug: "two-sum", text: "statement of two-sum"})
+	assert.Equal(t, m.previewed, "two-sum")
+}
@@ -0,0 +1,176 @@
+package table
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/phantompunk/kata/internal/domain"
+)
+
+// Output formats for the problem list, only FormatTable is interactive
+const (
+	FormatTable    = "table"
+	FormatPlain    = "plain"
+	FormatCSV      = "csv"
+	FormatJSON     = "json"
+	FormatMarkdown = "markdown"
+)
+
+var Formats = []string{FormatTable, FormatPlain, FormatCSV, FormatJSON, FormatMarkdown}
+
+// QuestionJSON is a question's row in json output, tracks are keyed by name
+type QuestionJSON struct {
+	ID         string               `json:"id"`
+	Title      string               `json:"title"`
+	Slug       string               `json:"slug"`
+	Difficulty string               `json:"difficulty"`
+	Tracks     map[string]TrackJSON `json:"tracks"`
+}
+
+// TrackJSON is a question's progress in one track, LastAttempted is null
+// until the first attempt
+type TrackJSON struct {
+	Solved         bool       `json:"solved"`
+	TimesSolved    int        `json:"timesSolved"`
+	FailedAttempts int        `json:"failedAttempts"`
+	LastAttempted  *time.Time `json:"lastAttempted"`
+}
+
+// Write prints the questions in a non-interactive format, one row per question
+func Write(w io.Writer, format string, questions []domain.QuestionStat, tracks []string) error {
+	switch format {
+	case FormatPlain:
+		return writePlain(w, questions, tracks)
+	case FormatCSV:
+		return writeCSV(w, questions, tracks)
+	case FormatJSON:
+		return writeJSON(w, questions, tracks)
+	case FormatMarkdown:
+		return writeMarkdown(w, questions, tracks)
+	default:
+		return fmt.Errorf("unsupported format %q", format)
+	}
+}
+
+func writePlain(w io.Writer, questions []domain.QuestionStat, tracks []string) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, strings.Join(header(tracks), "\t"))
+	for _, question := range questions {
+		fmt.Fprintln(tw, strings.Join(record(question, tracks, false), "\t"))
+	}
+	return tw.Flush()
+}
+
+func writeCSV(w io.Writer, questions []domain.QuestionStat, tracks []string) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(header(tracks)); err != nil {
+		return err
+	}
+	for _, question := range questions {
+		if err := cw.Write(record(question, tracks, true)); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+func writeJSON(w io.Writer, questions []domain.QuestionStat, tracks []string) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(ToJSON(questions, tracks))
+}
+
+// ToJSON converts questions to the objects written by the json format
+func ToJSON(questions []domain.QuestionStat, tracks []string) []QuestionJSON {
+	items := make([]QuestionJSON, 0, len(questions))
+	for _, question := range questions {
+		item := QuestionJSON{
+			ID:         question.ID,
+			Title:      question.Title,
+			Slug:       question.Slug,
+			Difficulty: question.Difficulty,
+			Tracks:     make(map[string]TrackJSON, len(tracks)),
+		}
+		for _, track := range tracks {
+			stat := question.Tracks[track]
+			var last *time.Time
+			if !stat.LastAttempted.IsZero() {
+				last = &stat.LastAttempted
+			}
+			item.Tracks[track] = TrackJSON{
+				Solved:         stat.Solved,
+				TimesSolved:    stat.TimesSolved,
+				FailedAttempts: stat.FailedAttempts,
+				LastAttempted:  last,
+			}
+		}
+		items = append(items, item)
+	}
+	return items
+}
+
+func writeMarkdown(w io.Writer, questions []domain.QuestionStat, tracks []string) error {
+	columns := header(tracks)
+	divider := make([]string, len(columns))
+	for i := range divider {
+		divider[i] = "---"
+	}
+
+	fmt.Fprintf(w, "| %s |\n", strings.Join(columns, " | "))
+	fmt.Fprintf(w, "| %s |\n", strings.Join(divider, " | "))
+	for _, question := range questions {
+		cells := record(question, tracks, false)
+		for i, cell := range cells {
+			cells[i] = strings.ReplaceAll(cell, "|", `\|`)
+		}
+		// READMEs render the solved column better as icons
+		for t, track := range tracks {
+			cells[3+t*4] = "❌"
+			if question.Tracks[track].Solved {
+				cells[3+t*4] = "✅"
+			}
+		}
+		if _, err := fmt.Fprintf(w, "| %s |\n", strings.Join(cells, " | ")); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func header(tracks []string) []string {
+	columns := []string{"ID", "Name", "Difficulty"}
+	for _, track := range tracks {
+		columns = append(columns, track, track+" Solved", track+" Failed", track+" Last")
+	}
+	return columns
+}
+
+// record builds the cells of a question row, exact rows use dates instead of
+// relative ages so the output stays stable for scripts
+func record(question domain.QuestionStat, tracks []string, exact bool) []string {
+	cells := []string{question.ID, question.Title, question.Difficulty}
+	for _, track := range tracks {
+		stat := question.Tracks[track]
+		status := "no"
+		if stat.Solved {
+			status = "yes"
+		}
+
+		last := shortAge(stat.LastAttempted, time.Now())
+		if exact {
+			last = ""
+			if !stat.LastAttempted.IsZero() {
+				last = stat.LastAttempted.Format(time.DateTime)
+			}
+		}
+
+		cells = append(cells, status, fmt.Sprint(stat.TimesSolved), fmt.Sprint(stat.FailedAttempts), last)
+	}
+	return cells
+}
@@ -0,0 +1,69 @@
+package table
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/phantompunk/kata/internal/domain"
+	"github.com/phantompunk/kata/pkg/assert"
+)
+
+func sampleQuestions() []domain.QuestionStat {
+	return []domain.QuestionStat{
+		{
+			ID:         "1",
+			Title:      "Two Sum",
+			Difficulty: "Easy",
+			Tracks: map[string]domain.TrackStat{
+				"go": {Solved: true, TimesSolved: 2, FailedAttempts: 1, LastAttempted: time.Date(2026, 3, 4, 5, 6, 7, 0, time.UTC)},
+			},
+		},
+		{ID: "2", Title: "Add Two Numbers, | Again", Difficulty: "Medium"},
+	}
+}
+
+func TestWriteCSV(t *testing.T) {
+	var buf bytes.Buffer
+	err := Write(&buf, FormatCSV, sampleQuestions(), []string{"go"})
+	assert.NilError(t, err)
+
+	want := "ID,Name,Difficulty,go,go Solved,go Failed,go Last\n" +
+		"1,Two Sum,Easy,yes,2,1,2026-03-04 05:06:07\n" +
+		"2,\"Add Two Numbers, | Again\",Medium,no,0,0,\n"
+	assert.Equal(t, buf.String(), want)
+}
+
+func TestWriteMarkdown(t *testing.T) {
+	var buf bytes.Buffer
+	err := Write(&buf, FormatMarkdown, sampleQuestions(), []string{"go"})
+	assert.NilError(t, err)
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	assert.Equal(t, len(lines), 4)
+	assert.Equal(t, lines[0], "| ID | Name | Difficulty | go | go Solved | go Failed | go Last |")
+	assert.Equal(t, lines[1], "| --- | --- | --- | --- | --- | --- | --- |")
+	assert.True(t, strings.HasPrefix(lines[2], "| 1 | Two Sum | Easy | ✅ | 2 | 1 |"))
+	assert.Equal(t, lines[3], `| 2 | Add Two Numbers, \| Again | Medium | ❌ | 0 | 0 | - |`)
+}
+
+func TestWriteJSON(t *testing.T) {
+	var buf bytes.Buffer
+	err := Write(&buf, FormatJSON, sampleQuestions(), []string{"go"})
+	assert.NilError(t, err)
+
+	var got []QuestionJSON
+	assert.NilError(t, json.Unmarshal(buf.Bytes(), &got))
+	assert.Equal(t, len(got), 2)
+	assert.True(t, got[0].Tracks["go"].Solved)
+	assert.Equal(t, got[0].Tracks["go"].TimesSolved, 2)
+	assert.True(t, got[1].Tracks["go"].LastAttempted == nil)
+}
+
+func TestWriteUnsupportedFormat(t *testing.T) {
+	var buf bytes.Buffer
+	err := Write(&buf, FormatTable, sampleQuestions(), []string{"go"})
+	assert.NotNil(t, err)
+}
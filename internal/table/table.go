@@ -3,6 +3,7 @@ package table
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/charmbracelet/bubbles/table"
 	tea "github.com/charmbracelet/bubbletea"
@@ -17,7 +18,8 @@ const (
 	DefaultNameWidth   = 30
 	DefaultDiffWidth   = 14
 	DefaultLangPadding = 2
-	MinTableHeight     = 3
+	DefaultStatWidth   = 8
+	DefaultLastWidth   = 8
 )
 
 // TableConfig holds table configuration options
@@ -27,6 +29,8 @@ type TableConfig struct {
 	NameWidth   int
 	DiffWidth   int
 	LangPadding int
+	StatWidth   int
+	LastWidth   int
 	Styles      *StyleConfig
 }
 
@@ -46,6 +50,8 @@ func DefaultConfig() *TableConfig {
 		NameWidth:   DefaultNameWidth,
 		DiffWidth:   DefaultDiffWidth,
 		LangPadding: DefaultLangPadding,
+		StatWidth:   DefaultStatWidth,
+		LastWidth:   DefaultLastWidth,
 		Styles:      DefaultStyles(),
 	}
 }
@@ -95,9 +101,11 @@ func (m model) View() string {
 }
 
 // Render creates and displays an interactive table with the given questions and languages
-// Uses default configuration
-func Render(questions []domain.QuestionStat, languages []string) error {
-	return RenderWithConfig(questions, languages, DefaultConfig())
+// Uses default configuration with the given number of visible rows
+func Render(questions []domain.QuestionStat, languages []string, height int) error {
+	config := DefaultConfig()
+	config.MaxHeight = height
+	return RenderWithConfig(questions, languages, config)
 }
 
 // RenderWithConfig creates and displays an interactive table with custom configuration
@@ -147,8 +155,8 @@ func validateInputs(questions []domain.QuestionStat, languages []string, config
 		return errors.New("languages slice cannot be empty")
 	}
 
-	if config.MaxHeight < MinTableHeight {
-		return fmt.Errorf("max height must be at least %d, got %d", MinTableHeight, config.MaxHeight)
+	if config.MaxHeight < domain.MinListHeight {
+		return fmt.Errorf("max height must be at least %d, got %d", domain.MinListHeight, config.MaxHeight)
 	}
 
 	return nil
@@ -182,8 +190,8 @@ func calculateTableHeight(rowCount, maxHeight int) int {
 	if height > maxHeight {
 		return maxHeight
 	}
-	if height < MinTableHeight {
-		return MinTableHeight
+	if height < domain.MinListHeight {
+		return domain.MinListHeight
 	}
 	return height
 }
@@ -198,11 +206,17 @@ func createRows(questions []domain.QuestionStat, tracks []string, config *TableC
 		}
 
 		for _, lang := range tracks {
+			stat := question.Tracks[lang]
 			status := "❌"
-			if question.LangStatus[lang] {
+			if stat.Solved {
 				status = "✅"
 			}
-			row = append(row, config.Styles.Center.Width(len(lang)).Render(status))
+			row = append(row,
+				config.Styles.Center.Width(len(lang)).Render(status),
+				config.Styles.Center.Width(config.StatWidth).Render(fmt.Sprint(stat.TimesSolved)),
+				config.Styles.Center.Width(config.StatWidth).Render(fmt.Sprint(stat.FailedAttempts)),
+				config.Styles.Center.Width(config.LastWidth).Render(shortAge(stat.LastAttempted, time.Now())),
+			)
 		}
 		rows = append(rows, row)
 	}
@@ -234,14 +248,37 @@ func createColumns(languages []string, config *TableConfig) []table.Column {
 
 	for _, lang := range languages {
 		langWidth := calculateLangWidth(lang, config.LangPadding)
-		columns = append(columns, table.Column{
-			Title: config.Styles.Center.Render(lang),
-			Width: langWidth,
-		})
+		columns = append(columns,
+			table.Column{Title: config.Styles.Center.Render(lang), Width: langWidth},
+			table.Column{Title: config.Styles.Center.Width(config.StatWidth).Render("Solved"), Width: config.StatWidth},
+			table.Column{Title: config.Styles.Center.Width(config.StatWidth).Render("Failed"), Width: config.StatWidth},
+			table.Column{Title: config.Styles.Center.Width(config.LastWidth).Render("Last"), Width: config.LastWidth},
+		)
 	}
 	return columns
 }
 
+// shortAge formats how long ago a problem was attempted in a few characters
+func shortAge(t, now time.Time) string {
+	if t.IsZero() {
+		return "-"
+	}
+
+	days := int(now.Sub(t).Hours() / 24)
+	switch {
+	case days < 1:
+		return "today"
+	case days < 14:
+		return fmt.Sprintf("%dd", days)
+	case days < 60:
+		return fmt.Sprintf("%dw", days/7)
+	case days < 365:
+		return fmt.Sprintf("%dmo", days/30)
+	default:
+		return fmt.Sprintf("%dy", days/365)
+	}
+}
+
 // colorize applies styling to difficulty text based on its value
 func colorize(difficulty string, styles *StyleConfig) string {
 	if style, exists := styles.Difficulty[difficulty]; exists {
@@ -2,32 +2,89 @@ package ui
 
 import (
 	"errors"
+	"fmt"
+	"strings"
 
 	"github.com/phantompunk/kata/internal/app"
 	"github.com/phantompunk/kata/internal/config"
 	"github.com/phantompunk/kata/internal/leetcode"
+	"github.com/phantompunk/kata/internal/runner"
+	"github.com/phantompunk/kata/internal/srs"
+	"github.com/phantompunk/kata/internal/validator"
 )
 
+// Error kinds for JSON output that are not tied to a sentinel error
+const (
+	KindUsage      = "usage"
+	KindUnexpected = "unexpected"
+)
+
+// knownError pairs a sentinel error with its kind and a user-friendly message
+type knownError struct {
+	target  error
+	kind    string
+	message string
+}
+
+var knownErrors = []knownError{
+	{leetcode.ErrQuestionNotFound, "question_not_found", "No matching question found. Please check the problem slug"},
+	{leetcode.ErrUnauthorized, "unauthorized", "Session is invalid or expired. Sign in to https://leetcode.com then run 'kata login'"},
+	{leetcode.ErrNotAuthenticated, "not_authenticated", "Not authenticated. Please run 'kata login'"},
+	{app.ErrDuplicateProblem, "duplicate_problem", "Problem already exists, use --force to overwrite"},
+	{app.ErrCookiesNotFound, "cookies_not_found", "Session not found. Please sign in to https://leetcode.com then run 'kata login' again"},
+	{app.ErrInvalidSession, "invalid_session", "Session expired. Please sign in to https://leetcode.com then run 'kata login' again"},
+	{app.ErrUnknownProblemID, "unknown_problem_id", "Unknown problem number. Run 'kata sync' to update the problem catalog or use the problem slug"},
+	{app.ErrCatalogEmpty, "catalog_empty", "Problem catalog is empty. Run 'kata sync' to download it"},
+	{app.ErrUnknownSubmission, "unknown_submission", "No pending submission with that id. Results are only kept until their verdict has been shown"},
+	{app.ErrInvalidTestcase, "invalid_testcase", "Test case needs one value per parameter, e.g. kata testcase add two-sum '[3,3]' 6"},
+	{app.ErrTestcaseNotFound, "testcase_not_found", "Test case not found. Run 'kata testcase list <slug>' to see their numbers"},
+	{app.ErrNoCustomTestcases, "no_custom_testcases", "No custom test cases found. Add one with 'kata testcase add' or edit testcases.txt"},
+	{app.ErrNoAttempts, "no_attempts", "No attempts recorded yet. Run 'kata test' or 'kata submit' first"},
+	{app.ErrAttemptNotFound, "attempt_not_found", "Attempt not found. Run 'kata history <slug>' to see their numbers"},
+	{app.ErrNoSnapshot, "no_snapshot", "That attempt was recorded before kata kept solution code"},
+	{app.ErrNoSnippetMarkers, "no_snippet_markers", "No ::KATA START:: marker found in the solution. Wrap your code in the markers or run 'kata solve <slug> --reset'"},
+	{app.ErrInvalidSharedName, "invalid_shared_name", "Invalid shared snippet name. Use the name of a file in the shared directory, like union_find"},
+	{app.ErrEmptySnippet, "empty_snippet", "The snippet markers enclose no code. Write your solution between ::KATA START:: and ::KATA END::"},
+	{app.ErrSharedNotFound, "shared_not_found", "Shared snippet not found. Helpers live in the shared directory next to your problems, e.g. ~/katas/python/shared/union_find.py"},
+	{validator.ErrInvalidSnippet, "invalid_snippet", "Solution has a syntax error, nothing was submitted"},
+	{app.ErrNoReviews, "no_reviews", "Nothing to review yet. Problems are scheduled once you submit a solution"},
+	{srs.ErrInvalidGrade, "invalid_grade", "Invalid rating. Use one of: again, hard, good or easy"},
+	{app.ErrNoQuestions, "no_questions", "No questions found in the database. Please run `kata get` to fetch questions"},
+	{config.ErrUnsupportedLanguage, "unsupported_language", "Language not supported. Add it under languages in the config"},
+	{runner.ErrUnsupportedLanguage, "local_unsupported_language", "Local testing needs a test command for this language. Run without --local to test on LeetCode"},
+	{runner.ErrToolchainNotFound, "toolchain_not_found", "Language toolchain not found. Install it and make sure it is on your $PATH"},
+	{runner.ErrTestFileNotFound, "test_file_not_found", "Test file not found. Run 'kata get <slug> --force' to generate it"},
+}
+
 // FormatError converts known error types into user-friendly messages
 func FormatError(err error) string {
-	switch {
-	case errors.Is(err, leetcode.ErrQuestionNotFound):
-		return "No matching question found. Please check the problem slug"
-	case errors.Is(err, leetcode.ErrUnauthorized):
-		return "Session is invalid or expired. Sign in to https://leetcode.com then run 'kata login'"
-	case errors.Is(err, leetcode.ErrNotAuthenticated):
-		return "Not authenticated. Please run 'kata login'"
-	case errors.Is(err, app.ErrDuplicateProblem):
-		return "Problem already exists, use --force to overwrite"
-	case errors.Is(err, app.ErrCookiesNotFound):
-		return "Session not found. Please sign in to https://leetcode.com then run 'kata login' again"
-	case errors.Is(err, app.ErrInvalidSession):
-		return "Session expired. Please sign in to https://leetcode.com then run 'kata login' again"
-	case errors.Is(err, app.ErrNoQuestions):
-		return "No questions found in the database. Please run `kata get` to fetch questions"
-	case errors.Is(err, config.ErrUnsupportedLanguage):
-		return "Supported languages: cpp, golang, java, python3, javascript"
-	default:
-		return "An unexpected error occurred. Please try again"
+	if known, ok := findKnownError(err); ok {
+		// Languages from the config are only known at runtime
+		if known.target == config.ErrUnsupportedLanguage {
+			return fmt.Sprintf("%s. Supported languages: %s", known.message, strings.Join(config.SupportedLanguages(), ", "))
+		}
+		// Syntax errors are only useful with where they are
+		if known.target == validator.ErrInvalidSnippet {
+			return fmt.Sprintf("%s\n%v", known.message, err)
+		}
+		return known.message
+	}
+	return "An unexpected error occurred. Please try again"
+}
+
+// ErrorKind names the kind of a known error for JSON output
+func ErrorKind(err error) string {
+	if known, ok := findKnownError(err); ok {
+		return known.kind
+	}
+	return KindUnexpected
+}
+
+func findKnownError(err error) (knownError, bool) {
+	for _, known := range knownErrors {
+		if errors.Is(err, known.target) {
+			return known, true
+		}
 	}
+	return knownError{}, false
 }
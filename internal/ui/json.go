@@ -0,0 +1,287 @@
+package ui
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/phantompunk/kata/internal/domain"
+	"github.com/phantompunk/kata/internal/leetcode"
+	"github.com/phantompunk/kata/internal/render"
+	"github.com/phantompunk/kata/internal/repository"
+	"github.com/phantompunk/kata/internal/runner"
+)
+
+// Output modes selected with the global --output flag
+const (
+	OutputText = "text"
+	OutputJSON = "json"
+)
+
+var Outputs = []string{OutputText, OutputJSON}
+
+// jsonDocument collects everything a command shows while JSON output is on,
+// it is written as a single object once the command finishes
+type jsonDocument struct {
+	OK       bool          `json:"ok"`
+	Result   any           `json:"result,omitempty"`
+	Messages []jsonMessage `json:"messages,omitempty"`
+	Error    *jsonError    `json:"error,omitempty"`
+}
+
+type jsonMessage struct {
+	Level string `json:"level"`
+	Text  string `json:"text"`
+}
+
+type jsonError struct {
+	Kind    string `json:"kind"`
+	Message string `json:"message"`
+	Detail  string `json:"detail,omitempty"`
+}
+
+type presenterKey struct{}
+
+// WithPresenter returns a context carrying the presenter commands share, so
+// everything a command shows ends up in the same JSON document
+func WithPresenter(ctx context.Context, p *Presenter) context.Context {
+	return context.WithValue(ctx, presenterKey{}, p)
+}
+
+// PresenterFrom returns the presenter carried by ctx, or a text presenter
+func PresenterFrom(ctx context.Context) *Presenter {
+	if p, ok := ctx.Value(presenterKey{}).(*Presenter); ok {
+		return p
+	}
+	return NewPresenter()
+}
+
+// SetOutput switches the presenter to the given output mode, JSON output
+// collects messages and results for WriteJSON instead of writing text
+func (p *Presenter) SetOutput(output string) {
+	p.doc = nil
+	if output == OutputJSON {
+		p.doc = &jsonDocument{}
+		p.writer = io.Discard
+	}
+}
+
+// JSONOutput reports whether the presenter produces JSON instead of text
+func (p *Presenter) JSONOutput() bool {
+	return p.doc != nil
+}
+
+// RecordError keeps a command's error with its kind for the JSON document,
+// verbose adds the underlying error. It does nothing for text output.
+func (p *Presenter) RecordError(err error, verbose bool) {
+	if p.doc == nil || err == nil {
+		return
+	}
+
+	p.doc.Error = &jsonError{Kind: ErrorKind(err), Message: FormatError(err)}
+	if verbose {
+		p.doc.Error.Detail = err.Error()
+	}
+}
+
+// WriteJSON writes the collected document. Errors that never reached
+// RecordError, like invalid flags or arguments, are reported as usage errors.
+func (p *Presenter) WriteJSON(w io.Writer, err error) error {
+	if p.doc == nil {
+		return nil
+	}
+
+	if err != nil && p.doc.Error == nil {
+		p.doc.Error = &jsonError{Kind: KindUsage, Message: err.Error()}
+	}
+	p.doc.OK = p.doc.Error == nil
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(p.doc)
+}
+
+// ShowResult sets the structured result of a command whose text output is
+// not written by the presenter, like the list table
+func (p *Presenter) ShowResult(result any) {
+	if p.doc != nil {
+		p.doc.Result = result
+	}
+}
+
+// result is the structured result set so far, nil for text output
+func (p *Presenter) result() any {
+	if p.doc == nil {
+		return nil
+	}
+	return p.doc.Result
+}
+
+func (p *Presenter) message(level, text string) bool {
+	if p.doc == nil {
+		return false
+	}
+	if text = strings.TrimSpace(text); text != "" {
+		p.doc.Messages = append(p.doc.Messages, jsonMessage{Level: level, Text: text})
+	}
+	return true
+}
+
+type verdictJSON struct {
+	Kind              string     `json:"kind"`
+	Status            string     `json:"status"`
+	Accepted          bool       `json:"accepted"`
+	Runtime           string     `json:"runtime,omitempty"`
+	RuntimePercentile *float64   `json:"runtimePercentile,omitempty"`
+	Memory            string     `json:"memory,omitempty"`
+	MemoryPercentile  *float64   `json:"memoryPercentile,omitempty"`
+	TotalCorrect      int        `json:"totalCorrect"`
+	TotalTestcases    int        `json:"totalTestcases"`
+	Error             string     `json:"error,omitempty"`
+	Cases             []caseJSON `json:"cases,omitempty"`
+	FailedCase        *caseJSON  `json:"failedCase,omitempty"`
+	SavedCase         bool       `json:"savedCase,omitempty"`
+}
+
+type caseJSON struct {
+	Input    string `json:"input,omitempty"`
+	Output   string `json:"output"`
+	Expected string `json:"expected"`
+	Stdout   string `json:"stdout,omitempty"`
+	Passed   bool   `json:"passed"`
+}
+
+func toVerdictJSON(result *leetcode.SubmissionResult) verdictJSON {
+	verdict := verdictJSON{
+		Kind:              domain.AttemptTest,
+		Status:            result.Status.String(),
+		Accepted:          result.IsAccepted(),
+		Runtime:           result.Runtime,
+		RuntimePercentile: result.RuntimePercentile,
+		Memory:            result.Memory,
+		MemoryPercentile:  result.MemoryPercentile,
+		TotalCorrect:      result.TotalCorrect,
+		TotalTestcases:    result.TotalTestcases,
+		Error:             result.FullError,
+		SavedCase:         result.SavedCase,
+	}
+	if result.IsSolution {
+		verdict.Kind = domain.AttemptSubmit
+	}
+	if verdict.Error == "" {
+		verdict.Error = result.Error
+	}
+
+	for _, c := range result.Cases {
+		verdict.Cases = append(verdict.Cases, toCaseJSON(c))
+	}
+	if result.LastCase != nil {
+		failed := toCaseJSON(*result.LastCase)
+		verdict.FailedCase = &failed
+	}
+	return verdict
+}
+
+func toCaseJSON(c leetcode.CaseResult) caseJSON {
+	return caseJSON{Input: c.Input, Output: c.Output, Expected: c.Expected, Stdout: c.Stdout, Passed: c.Passed}
+}
+
+type localResultJSON struct {
+	Passed int             `json:"passed"`
+	Total  int             `json:"total"`
+	Cases  []localCaseJSON `json:"cases"`
+	Output string          `json:"output,omitempty"`
+}
+
+type localCaseJSON struct {
+	Name   string `json:"name"`
+	Passed bool   `json:"passed"`
+	Output string `json:"output,omitempty"`
+}
+
+func toLocalResultJSON(result *runner.Result) localResultJSON {
+	view := localResultJSON{Cases: []localCaseJSON{}, Output: result.Output}
+	for _, c := range result.Cases {
+		view.Cases = append(view.Cases, localCaseJSON{Name: c.Name, Passed: c.Passed, Output: c.Output})
+	}
+	view.Passed = result.PassedCount()
+	view.Total = len(result.Cases)
+	return view
+}
+
+type problemJSON struct {
+	ID            string     `json:"id"`
+	Title         string     `json:"title"`
+	Slug          string     `json:"slug"`
+	Difficulty    string     `json:"difficulty"`
+	Language      string     `json:"language"`
+	Status        string     `json:"status,omitempty"`
+	LastAttempted *time.Time `json:"lastAttempted,omitempty"`
+}
+
+func toProblemJSON(problem *domain.Problem) problemJSON {
+	view := problemJSON{
+		ID:         problem.ID,
+		Title:      problem.Title,
+		Slug:       problem.Slug,
+		Difficulty: problem.Difficulty,
+		Language:   problem.Language.Slug(),
+		Status:     problem.Status,
+	}
+	if !problem.LastAttempted.IsZero() {
+		view.LastAttempted = &problem.LastAttempted
+	}
+	return view
+}
+
+type statementJSON struct {
+	Problem     problemJSON     `json:"problem"`
+	Statement   string          `json:"statement"`
+	LastAttempt *domain.Attempt `json:"lastAttempt"`
+}
+
+func toStatementJSON(problem *domain.Problem, statement string, last *domain.Attempt) statementJSON {
+	return statementJSON{Problem: toProblemJSON(problem), Statement: statement, LastAttempt: last}
+}
+
+type loginJSON struct {
+	Username  string `json:"username"`
+	Attempted int64  `json:"attempted"`
+	Completed int64  `json:"completed"`
+	Streak    int    `json:"streak"`
+}
+
+func toLoginJSON(username string, stats repository.GetStatsRow, streak int) loginJSON {
+	return loginJSON{Username: username, Attempted: stats.Attempted, Completed: stats.Completed, Streak: streak}
+}
+
+type dailyJSON struct {
+	Date       string      `json:"date"`
+	Title      string      `json:"title"`
+	Difficulty string      `json:"difficulty"`
+	Stub       *renderJSON `json:"stub,omitempty"`
+}
+
+type renderJSON struct {
+	Slug             string   `json:"slug"`
+	DirectoryCreated string   `json:"directoryCreated,omitempty"`
+	FilesCreated     []string `json:"filesCreated"`
+	FilesUpdated     []string `json:"filesUpdated"`
+	FilesSkipped     []string `json:"filesSkipped"`
+	TestSkipped      bool     `json:"testSkipped"`
+	Warnings         []string `json:"warnings,omitempty"`
+}
+
+func toRenderJSON(result *render.RenderResult, slug string) renderJSON {
+	return renderJSON{
+		Slug:             slug,
+		DirectoryCreated: result.DirectoryCreated,
+		FilesCreated:     result.FilesCreated,
+		FilesUpdated:     result.FilesUpdated,
+		FilesSkipped:     result.FilesSkipped,
+		TestSkipped:      result.TestSkipped,
+		Warnings:         result.Warnings,
+	}
+}
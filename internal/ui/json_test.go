@@ -0,0 +1,106 @@
+package ui
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/phantompunk/kata/internal/app"
+	"github.com/phantompunk/kata/internal/domain"
+	"github.com/phantompunk/kata/internal/leetcode"
+	"github.com/phantompunk/kata/internal/render"
+	"github.com/phantompunk/kata/pkg/assert"
+)
+
+func decode(t *testing.T, buf *bytes.Buffer) jsonDocument {
+	t.Helper()
+	var doc jsonDocument
+	assert.NilError(t, json.Unmarshal(buf.Bytes(), &doc))
+	return doc
+}
+
+func jsonPresenter() *Presenter {
+	p := NewPresenter()
+	p.SetOutput(OutputJSON)
+	return p
+}
+
+func TestJSONOutputCollectsMessagesAndResult(t *testing.T) {
+	p := jsonPresenter()
+	p.ShowProblemFetched("Two Sum")
+	p.ShowSubmissionResults(&leetcode.SubmissionResult{Status: leetcode.StatusAccepted, IsSolution: true, Runtime: "3 ms"})
+
+	var buf bytes.Buffer
+	assert.NilError(t, p.WriteJSON(&buf, nil))
+
+	doc := decode(t, &buf)
+	assert.True(t, doc.OK)
+	assert.Equal(t, doc.Messages[0].Level, "success")
+	assert.Equal(t, doc.Messages[0].Text, "Fetched problem: Two Sum")
+
+	result := doc.Result.(map[string]any)
+	assert.Equal(t, result["kind"], any("submit"))
+	assert.Equal(t, result["accepted"], any(true))
+	assert.Equal(t, result["runtime"], any("3 ms"))
+}
+
+func TestJSONOutputRecordsErrorKind(t *testing.T) {
+	p := jsonPresenter()
+	err := fmt.Errorf("listing questions: %w", app.ErrNoQuestions)
+	p.RecordError(err, false)
+
+	var buf bytes.Buffer
+	assert.NilError(t, p.WriteJSON(&buf, errors.New("shown to the user")))
+
+	doc := decode(t, &buf)
+	assert.False(t, doc.OK)
+	assert.Equal(t, doc.Error.Kind, "no_questions")
+	assert.Equal(t, doc.Error.Message, FormatError(err))
+	assert.Equal(t, doc.Error.Detail, "")
+}
+
+func TestJSONOutputReportsUsageErrors(t *testing.T) {
+	p := jsonPresenter()
+
+	var buf bytes.Buffer
+	assert.NilError(t, p.WriteJSON(&buf, errors.New(`invalid sort "size"`)))
+
+	doc := decode(t, &buf)
+	assert.Equal(t, doc.Error.Kind, KindUsage)
+	assert.Equal(t, doc.Error.Message, `invalid sort "size"`)
+}
+
+func TestJSONOutputNestsDailyStub(t *testing.T) {
+	p := jsonPresenter()
+	p.ShowDailyChallenge("2026-10-17", "Two Sum", "Easy")
+	p.ShowRenderResults(&render.RenderResult{FilesCreated: []string{"two_sum.go"}}, "two-sum", false)
+
+	var buf bytes.Buffer
+	assert.NilError(t, p.WriteJSON(&buf, nil))
+
+	result := decode(t, &buf).Result.(map[string]any)
+	assert.Equal(t, result["title"], any("Two Sum"))
+	stub := result["stub"].(map[string]any)
+	assert.Equal(t, stub["slug"], any("two-sum"))
+}
+
+func TestJSONOutputKeepsPresentersApart(t *testing.T) {
+	p := jsonPresenter()
+	p.ShowTestcaseRemoved(1, domain.Testcase{Input: "[3,3]\n6"})
+	NewPresenterWithWriter(&bytes.Buffer{}).ShowTestcaseAdded("Two Sum", "two_sum/testcases.txt")
+
+	var buf bytes.Buffer
+	assert.NilError(t, p.WriteJSON(&buf, nil))
+
+	doc := decode(t, &buf)
+	result := doc.Result.(map[string]any)
+	assert.Equal(t, result["removed"], any(float64(1)))
+	assert.Equal(t, len(doc.Messages), 1)
+}
+
+func TestErrorKindDefaultsToUnexpected(t *testing.T) {
+	assert.Equal(t, ErrorKind(errors.New("boom")), KindUnexpected)
+	assert.Equal(t, ErrorKind(leetcode.ErrUnauthorized), "unauthorized")
+}
@@ -0,0 +1,125 @@
+package ui
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// statementWidth is where problem statements wrap, wide enough for most
+// examples and narrow enough to read comfortably
+const statementWidth = 80
+
+var (
+	headingStyle = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("12"))
+	sectionStyle = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("10"))
+	codeStyle    = lipgloss.NewStyle().Foreground(lipgloss.Color("11"))
+	blockStyle   = lipgloss.NewStyle().
+			Border(lipgloss.NormalBorder(), false, false, false, true).
+			BorderForeground(lipgloss.Color("8")).
+			PaddingLeft(1)
+	boldStyle   = lipgloss.NewStyle().Bold(true)
+	italicStyle = lipgloss.NewStyle().Italic(true)
+	faintStyle  = lipgloss.NewStyle().Faint(true)
+
+	difficultyColors = map[string]lipgloss.Color{
+		"Easy":   lipgloss.Color("2"),
+		"Medium": lipgloss.Color("3"),
+		"Hard":   lipgloss.Color("1"),
+	}
+
+	inlinePattern  = regexp.MustCompile("`[^`]+`|\\*\\*[^*]+\\*\\*|\\*[^*\\s][^*]*\\*")
+	escapePattern  = regexp.MustCompile(`\\([\\` + "`" + `*_{}\[\]()#+\-.!|<>])`)
+	sectionPattern = regexp.MustCompile(`^\*\*[^*]+\*\*$`)
+)
+
+// styleMarkdown renders the markdown of a problem statement for the terminal.
+// It covers what LeetCode statements use: headings, example and constraint
+// sections, fenced code, lists and inline code or emphasis.
+func styleMarkdown(markdown string, width int) string {
+	var out []string
+	var block []string
+	inBlock := false
+	blank := true
+
+	for _, line := range strings.Split(markdown, "\n") {
+		trimmed := strings.TrimSpace(line)
+
+		if strings.HasPrefix(trimmed, "```") {
+			if inBlock {
+				out = append(out, blockStyle.Render(codeStyle.Render(strings.Join(block, "\n"))))
+				block = block[:0]
+				blank = false
+			}
+			inBlock = !inBlock
+			continue
+		}
+		if inBlock {
+			block = append(block, strings.TrimRight(line, " \t"))
+			continue
+		}
+
+		// Converted statements separate paragraphs with runs of blank lines
+		if trimmed == "" {
+			if !blank {
+				out = append(out, "")
+			}
+			blank = true
+			continue
+		}
+		blank = false
+
+		switch {
+		case strings.HasPrefix(trimmed, "#"):
+			out = append(out, headingStyle.Render(strings.TrimSpace(strings.TrimLeft(trimmed, "#"))))
+		case sectionPattern.MatchString(trimmed):
+			out = append(out, sectionStyle.Render(strings.Trim(trimmed, "*")))
+		case strings.HasPrefix(trimmed, "- "), strings.HasPrefix(trimmed, "* "):
+			item := lipgloss.NewStyle().Width(width - 4).Render(styleInline(trimmed[2:]))
+			out = append(out, lipgloss.JoinHorizontal(lipgloss.Top, "  • ", item))
+		default:
+			out = append(out, lipgloss.NewStyle().Width(width).Render(styleInline(trimmed)))
+		}
+	}
+
+	// An unterminated fence still shows its code
+	if inBlock && len(block) > 0 {
+		out = append(out, blockStyle.Render(codeStyle.Render(strings.Join(block, "\n"))))
+	}
+	return strings.TrimSpace(trimLines(strings.Join(out, "\n")))
+}
+
+// trimLines drops the padding lipgloss adds when it wraps to a width
+func trimLines(text string) string {
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " ")
+	}
+	return strings.Join(lines, "\n")
+}
+
+// styleInline styles code spans, bold and italic text within a line
+func styleInline(text string) string {
+	var b strings.Builder
+	last := 0
+	for _, loc := range inlinePattern.FindAllStringIndex(text, -1) {
+		b.WriteString(unescape(text[last:loc[0]]))
+		span := text[loc[0]:loc[1]]
+		switch {
+		case strings.HasPrefix(span, "`"):
+			b.WriteString(codeStyle.Render(strings.Trim(span, "`")))
+		case strings.HasPrefix(span, "**"):
+			b.WriteString(boldStyle.Render(styleInline(strings.Trim(span, "*"))))
+		default:
+			b.WriteString(italicStyle.Render(styleInline(strings.Trim(span, "*"))))
+		}
+		last = loc[1]
+	}
+	b.WriteString(unescape(text[last:]))
+	return b.String()
+}
+
+func unescape(text string) string {
+	return escapePattern.ReplaceAllString(text, "$1")
+}
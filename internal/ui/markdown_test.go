@@ -0,0 +1,31 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/phantompunk/kata/pkg/assert"
+)
+
+func TestStyleMarkdownStatement(t *testing.T) {
+	statement := "Return *indices of `target`* .\n\n \n\n**Example 1:**\n\n```\nInput: nums = [2,7]\n```\n\n**Constraints:**\n\n- `2 <= n <= 10^4`\n- 1 \\* 2"
+
+	want := strings.Join([]string{
+		"Return indices of target .",
+		"",
+		"Example 1:",
+		"",
+		"│ Input: nums = [2,7]",
+		"",
+		"Constraints:",
+		"",
+		"  • 2 <= n <= 10^4",
+		"  • 1 * 2",
+	}, "\n")
+	assert.Equal(t, styleMarkdown(statement, 80), want)
+}
+
+func TestStyleMarkdownWrapsParagraphs(t *testing.T) {
+	got := styleMarkdown("one two three four", 9)
+	assert.Equal(t, got, "one two\nthree\nfour")
+}
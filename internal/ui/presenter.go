@@ -5,25 +5,28 @@ import (
 	"io"
 	"os"
 	"strings"
+	"text/tabwriter"
 	"text/template"
 	"time"
 
 	"github.com/andanhm/go-prettytime"
+	"github.com/charmbracelet/lipgloss"
 	"github.com/dustin/go-humanize"
+	"github.com/dustin/go-humanize/english"
 	"github.com/phantompunk/kata/internal/domain"
 	"github.com/phantompunk/kata/internal/leetcode"
 	"github.com/phantompunk/kata/internal/render"
 	"github.com/phantompunk/kata/internal/repository"
+	"github.com/phantompunk/kata/internal/runner"
 )
 
 // Presenter handles all UI output and formatting
 type Presenter struct {
 	writer io.Writer
+	// doc collects messages and results instead of text when JSON output is on
+	doc *jsonDocument
 }
 
-// Default presenter for package-level operations
-var defaultPresenter = &Presenter{writer: os.Stdout}
-
 // Template definitions
 const (
 	quizTemplate = `
@@ -41,6 +44,7 @@ Next steps:
 	loginTemplate = `
 Account:	{{.Username}}
 Problems:	{{.Attempted}} attempted, {{.Completed}} completed
+Daily streak:	{{.Streak}}
 
 You're all set! 🎉
 
@@ -51,7 +55,7 @@ Next steps:
 `
 )
 
-// NewPresenter creates a new Presenter instance
+// NewPresenter creates a new Presenter instance writing text to stdout
 func NewPresenter() *Presenter {
 	return &Presenter{
 		writer: os.Stdout,
@@ -66,9 +70,9 @@ func NewPresenterWithWriter(w io.Writer) *Presenter {
 }
 
 // ShowWarnings displays a list of warning messages
-func ShowWarnings(warnings []string) {
+func (p *Presenter) ShowWarnings(warnings []string) {
 	for _, warning := range warnings {
-		defaultPresenter.error("%s", warning)
+		p.error("%s", warning)
 	}
 }
 
@@ -76,23 +80,40 @@ func ShowWarnings(warnings []string) {
 
 func (p *Presenter) success(format string, a ...any) {
 	msg := fmt.Sprintf(format, a...)
+	if p.message("success", msg) {
+		return
+	}
 	_, _ = fmt.Fprintf(p.writer, "✔ %s\n", msg)
 }
 
 func (p *Presenter) info(message string) {
+	if p.message("info", message) {
+		return
+	}
 	_, _ = fmt.Fprintf(p.writer, "ℹ %s\n", message)
 }
 
 func (p *Presenter) error(format string, a ...any) {
 	msg := fmt.Sprintf(format, a...)
+	if p.message("error", msg) {
+		return
+	}
 	_, _ = fmt.Fprintf(p.writer, "✘ %s\n", msg)
 }
 
+// print writes supporting text, JSON output leaves it out since results
+// carry the same details
 func (p *Presenter) print(message string) {
+	if p.doc != nil {
+		return
+	}
 	_, _ = fmt.Fprintln(p.writer, message)
 }
 
 func (p *Presenter) warning(message string) {
+	if p.message("warning", message) {
+		return
+	}
 	_, _ = fmt.Fprintf(p.writer, "⚠ %s\n", message)
 }
 
@@ -108,47 +129,179 @@ Next steps:
 // ShowQuizResult displays the quiz result using a template
 func (p *Presenter) ShowQuizResult(problem *domain.Problem) error {
 	p.success("Selected a random problem from your history")
+	p.ShowResult(toProblemJSON(problem))
 	return p.renderQuizResult(problem)
 }
 
-// ShowTestResults displays test execution results
+// ShowQuizResults displays the problems picked for a quiz, most in need of practice first
+func (p *Presenter) ShowQuizResults(problems []*domain.Problem) {
+	p.success("Selected %d problems from your history", len(problems))
+	p.print("")
+
+	views := make([]problemJSON, len(problems))
+	for i, problem := range problems {
+		views[i] = toProblemJSON(problem)
+	}
+	p.ShowResult(views)
+
+	w := tabwriter.NewWriter(p.writer, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "TITLE\tDIFFICULTY\tSTATUS\tLAST ATTEMPTED\t")
+	for _, problem := range problems {
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t\n", problem.Title, problem.Difficulty, problem.Status, prettytime.Format(problem.LastAttempted))
+	}
+	_ = w.Flush()
+
+	p.print(fmt.Sprintf("\nStart solving:\n  kata get %s --language %s", problems[0].Slug, problems[0].Language.Slug()))
+}
+
+// ShowTestResults displays the verdict and every case of a test run
 func (p *Presenter) ShowTestResults(result *leetcode.SubmissionResult, problem *domain.Problem) {
-	if result.HasError() {
-		p.print("")
-		p.error("Test failed:\n    Error: %q", result.ErrorMsg)
+	p.ShowResult(toVerdictJSON(result))
+	p.print("")
+	if result.Status.IsError() {
+		p.showVerdictError(result)
 		p.print("\nFix your code then try again")
 		return
 	}
 
-	if !result.IsCorrect() {
-		p.error("\nFailed on test case #%d", result.TestCase)
+	passed := 0
+	for i, c := range result.Cases {
+		if c.Passed {
+			passed++
+			p.success("Case %d", i+1)
+		} else {
+			p.error("Case %d", i+1)
+		}
+		p.showCase(c, !c.Passed)
+	}
 
-		p.print(fmt.Sprintf("\nInput:    %s", strings.ReplaceAll(problem.Testcases[result.TestCase], "\n", ", ")))
-		p.print(fmt.Sprintf("Output:   %s", result.TestOutput))
-		p.print(fmt.Sprintf("Expected: %s", result.TestExpected))
-		p.print("\nFix your code then try again")
+	p.print("")
+	if result.IsAccepted() {
+		p.success("All test cases passed")
+		p.print("")
+		p.info("You are ready to submit")
 		return
 	}
 
+	p.error("%s: %d of %d test cases passed", result.Status, passed, len(result.Cases))
+	p.print("\nFix your code then try again")
+}
+
+// ShowLocalTestResults displays the results of running tests with the local toolchain
+func (p *Presenter) ShowLocalTestResults(result *runner.Result) {
+	p.ShowResult(toLocalResultJSON(result))
 	p.print("")
-	p.success("All test cases passed")
+	for _, c := range result.Cases {
+		if c.Passed {
+			p.success("%s", c.Name)
+			continue
+		}
+		p.error("%s", c.Name)
+		if output := strings.TrimSpace(c.Output); output != "" {
+			p.print(indent(output, "    "))
+		}
+	}
 
 	p.print("")
-	p.info("You are ready to submit")
+	if result.AllPassed() {
+		p.success("All %d test cases passed", len(result.Cases))
+		p.print("")
+		p.info("You are ready to submit")
+		return
+	}
+
+	p.error("%d of %d test cases failed", result.FailedCount(), len(result.Cases))
+	p.print("\nFix your code then try again")
+}
+
+// ShowLocalTestOutput displays raw toolchain output when no test cases could be parsed
+func (p *Presenter) ShowLocalTestOutput(result *runner.Result) {
+	p.print("")
+	p.error("Tests failed to run:")
+	if result != nil {
+		p.ShowResult(toLocalResultJSON(result))
+		p.print(indent(strings.TrimSpace(result.Output), "    "))
+	}
+	p.print("\nFix your code then try again")
 }
 
-// ShowSubmissionResults displays submission results
+// ShowSubmissionResults displays the verdict of a solution submission
 func (p *Presenter) ShowSubmissionResults(result *leetcode.SubmissionResult) {
+	p.ShowResult(toVerdictJSON(result))
 	p.print("")
+	if !result.IsAccepted() {
+		if result.Status.IsError() {
+			p.showVerdictError(result)
+		} else {
+			p.error("%s", result.Status)
+		}
+
+		if result.TotalTestcases > 0 {
+			p.print(fmt.Sprintf("Passed:   %d/%d test cases", result.TotalCorrect, result.TotalTestcases))
+		}
+
+		if result.LastCase != nil && !result.Status.IsError() {
+			p.print("\nFailed on:")
+			p.showCase(*result.LastCase, true)
+		}
+
+		if result.SavedCase {
+			p.print("")
+			p.info(fmt.Sprintf("Saved the failing case to %s, kata test will include it", domain.TestcasesFile))
+		}
+		p.print("\nFix your code then try again")
+		return
+	}
+
 	p.success("Submission accepted!\n")
 
-	p.print(fmt.Sprintf("Result:  %s", result.Result))
-	p.print(fmt.Sprintf("Runtime:  %s (beats %s of Go submissions)", result.Runtime, result.RuntimePercentile))
-	p.print(fmt.Sprintf("Memory:   %s MB (beats %s)", result.Memory, result.MemoryPercentile))
+	p.print(fmt.Sprintf("Result:   %s", result.Status))
+	p.print(fmt.Sprintf("Runtime:  %s%s", result.Runtime, beats(result.RuntimePercentile)))
+	p.print(fmt.Sprintf("Memory:   %s%s", result.Memory, beats(result.MemoryPercentile)))
 
 	p.print("\n🎉 Great job! Your solution was accepted.")
 }
 
+// showVerdictError displays compile and runtime errors with the full message when available
+func (p *Presenter) showVerdictError(result *leetcode.SubmissionResult) {
+	p.error("%s", result.Status)
+
+	message := result.FullError
+	if message == "" {
+		message = result.Error
+	}
+	if message != "" {
+		p.print(indent(strings.TrimSpace(message), "    "))
+	}
+
+	if result.LastCase != nil && result.LastCase.Input != "" {
+		p.print(fmt.Sprintf("\nInput:    %s", strings.ReplaceAll(result.LastCase.Input, "\n", ", ")))
+	}
+}
+
+// showCase displays the stdout of a case and, when detailed, its input and outputs
+func (p *Presenter) showCase(c leetcode.CaseResult, detailed bool) {
+	if detailed {
+		if c.Input != "" {
+			p.print(fmt.Sprintf("    Input:    %s", strings.ReplaceAll(c.Input, "\n", ", ")))
+		}
+		p.print(fmt.Sprintf("    Output:   %s", c.Output))
+		p.print(fmt.Sprintf("    Expected: %s", c.Expected))
+	}
+
+	if stdout := strings.TrimSpace(c.Stdout); stdout != "" {
+		p.print("    Stdout:")
+		p.print(indent(stdout, "      "))
+	}
+}
+
+func beats(percentile *float64) string {
+	if percentile == nil {
+		return ""
+	}
+	return fmt.Sprintf(" (beats %.2f%%)", *percentile)
+}
+
 // ShowWaitForResults displays a progress indicator while waiting for results
 func (p *Presenter) ShowWaitForResults(start time.Time, wait time.Duration, done <-chan struct{}) {
 	ticker := time.NewTicker(1 * time.Second)
@@ -167,6 +320,133 @@ func (p *Presenter) ShowWaitForResults(start time.Time, wait time.Duration, done
 	}
 }
 
+// ShowCustomTestcases displays a numbered list of a problem's custom test cases
+func (p *Presenter) ShowCustomTestcases(title string, cases []domain.Testcase) {
+	p.ShowResult(cases)
+	if len(cases) == 0 {
+		p.info(fmt.Sprintf("No custom test cases for %s", title))
+		return
+	}
+
+	p.success("Custom test cases for %s:", title)
+	for i, c := range cases {
+		p.print(fmt.Sprintf("\n  %d. %s", i+1, strings.ReplaceAll(c.Input, "\n", ", ")))
+		if c.Expected != "" {
+			p.print(fmt.Sprintf("     expected: %s", c.Expected))
+		}
+	}
+}
+
+// ShowTestcaseAdded displays where a new custom test case was saved
+func (p *Presenter) ShowTestcaseAdded(title, path string) {
+	p.ShowResult(map[string]string{"title": title, "path": path})
+	p.success("Added test case to %s", title)
+	p.print(fmt.Sprintf("  • %s", path))
+}
+
+// ShowTestcaseRemoved displays the custom test case that was removed
+func (p *Presenter) ShowTestcaseRemoved(position int, testcase domain.Testcase) {
+	p.ShowResult(map[string]any{"removed": position, "testcase": testcase})
+	p.success("Removed test case %d: %s", position, strings.ReplaceAll(testcase.Input, "\n", ", "))
+}
+
+// ShowHistory displays a numbered table of a problem's attempts, oldest first
+func (p *Presenter) ShowHistory(title string, attempts []domain.Attempt) {
+	p.success("History of %s:", title)
+	p.ShowResult(attempts)
+	p.print("")
+
+	w := tabwriter.NewWriter(p.writer, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "#\tDATE\tKIND\tVERDICT\tRUNTIME\tMEMORY\t")
+	for _, a := range attempts {
+		verdict := a.Verdict
+		if a.Kind == domain.AttemptRetry {
+			verdict = "snapshot before retry"
+		}
+		_, _ = fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\t%s\t\n", a.Number, a.CreatedAt.Format("2006-01-02 15:04"), a.Kind, verdict, dash(a.Runtime), dash(a.Memory))
+	}
+	_ = w.Flush()
+}
+
+// ShowAttemptCode prints the code of a past attempt
+func (p *Presenter) ShowAttemptCode(attempt domain.Attempt) {
+	p.ShowResult(attempt)
+	p.print(attempt.Code)
+}
+
+// ShowDiff prints a unified diff between two versions of a solution
+func (p *Presenter) ShowDiff(changes string) {
+	p.ShowResult(map[string]string{"diff": changes})
+	if changes == "" {
+		p.info("No changes")
+		return
+	}
+	_, _ = fmt.Fprint(p.writer, changes)
+}
+
+func dash(value string) string {
+	if value == "" {
+		return "-"
+	}
+	return value
+}
+
+// ShowReviews displays the problems due for review, most overdue first
+func (p *Presenter) ShowReviews(reviews []domain.Review) {
+	p.success("%s due for review:", english.Plural(len(reviews), "problem", "problems"))
+	p.ShowResult(map[string]any{"due": reviews})
+	p.print("")
+
+	w := tabwriter.NewWriter(p.writer, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "ID\tTITLE\tDIFFICULTY\tLANGUAGE\tDUE\t")
+	for _, r := range reviews {
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t\n", r.ID, r.Title, r.Difficulty, r.Language, overdue(r.Due, time.Now()))
+	}
+	_ = w.Flush()
+
+	p.print(fmt.Sprintf("\nStart with:\n  kata get %s --retry --language %s", reviews[0].Slug, reviews[0].Language))
+}
+
+// overdue describes how many days ago a review became due
+func overdue(due, now time.Time) string {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	days := int(today.Sub(due).Hours() / 24)
+	if days <= 0 {
+		return "today"
+	}
+	return english.Plural(days, "day", "days") + " ago"
+}
+
+// ShowNothingDue displays when the next review is scheduled
+func (p *Presenter) ShowNothingDue(next time.Time) {
+	p.success("Nothing due for review today")
+	p.ShowResult(map[string]any{"due": []domain.Review{}, "nextReview": next})
+	p.info(fmt.Sprintf("Next review on %s", next.Format("Mon, Jan 2")))
+}
+
+// ShowGradePrompt asks how hard an accepted problem was to solve
+func (p *Presenter) ShowGradePrompt() {
+	_, _ = fmt.Fprint(p.writer, "\nHow hard was it? [h]ard, [g]ood, [e]asy (default good): ")
+}
+
+// ShowNextReview displays when an accepted problem comes up for review again
+func (p *Presenter) ShowNextReview(due time.Time, interval int) {
+	p.info(fmt.Sprintf("Next review in %s, on %s", english.Plural(interval, "day", "days"), due.Format("Mon, Jan 2")))
+}
+
+// ShowReviewNotDue displays the schedule of a problem solved before its review was due
+func (p *Presenter) ShowReviewNotDue(due time.Time) {
+	p.info(fmt.Sprintf("Not due for review yet, next review on %s", due.Format("Mon, Jan 2")))
+}
+
+// ShowResultPending displays how to fetch a verdict that is still being judged
+func (p *Presenter) ShowResultPending(submissionId string) {
+	p.print("")
+	p.warning("LeetCode is still judging this submission")
+	p.ShowResult(map[string]any{"pending": true, "submissionId": submissionId})
+	p.print(fmt.Sprintf("To check again later, run:\n  kata result %s", submissionId))
+}
+
 // ShowProblemFetched displays a success message for fetched problems
 func (p *Presenter) ShowProblemFetched(title string) {
 	p.success("Fetched problem: %s", title)
@@ -249,13 +529,117 @@ func (p *Presenter) ShowOpeningConfigFile(path string) {
 	p.success("Opening config file: %s", path)
 }
 
+// ShowSyncingCatalog displays a message indicating the catalog is being downloaded
+func (p *Presenter) ShowSyncingCatalog() {
+	_, _ = fmt.Fprint(p.writer, "✔ Syncing problem catalog")
+}
+
+// ShowSyncProgress displays a progress indicator for each synced page
+func (p *Presenter) ShowSyncProgress(synced, total int) {
+	_, _ = fmt.Fprint(p.writer, ".")
+}
+
+// ShowCatalogSynced displays the number of problems stored in the catalog
+func (p *Presenter) ShowCatalogSynced(count int) {
+	p.print("")
+	p.success("Synced %d problems", count)
+	p.ShowResult(map[string]int{"synced": count})
+}
+
+// ShowCatalog displays catalog entries as aligned columns
+func (p *Presenter) ShowCatalog(entries []domain.CatalogEntry) {
+	p.ShowResult(entries)
+	if len(entries) == 0 {
+		p.info("No problems match the given filters")
+		return
+	}
+
+	w := tabwriter.NewWriter(p.writer, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "ID\tTITLE\tDIFFICULTY\tACCEPTANCE\tTAGS\t")
+	for _, entry := range entries {
+		title := entry.Title
+		if entry.PaidOnly {
+			title += " 🔒"
+		}
+		if entry.Downloaded {
+			title += " ✔"
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%.1f%%\t%s\t\n", entry.ID, title, entry.Difficulty, entry.AcRate, strings.Join(entry.Tags, ", "))
+	}
+	_ = w.Flush()
+}
+
+// ShowCatalogPick displays the problem randomly selected from the catalog
+func (p *Presenter) ShowCatalogPick(entry domain.CatalogEntry, matches int) {
+	p.info(fmt.Sprintf("Picked %s. %s (%s) from %d matching problems", entry.ID, entry.Title, entry.Difficulty, matches))
+}
+
+// ShowDailyChallenge displays the date and problem of today's daily challenge
+func (p *Presenter) ShowDailyChallenge(date, title, difficulty string) {
+	p.ShowResult(&dailyJSON{Date: date, Title: title, Difficulty: difficulty})
+	p.success("Daily challenge for %s: %s (%s)", date, title, difficulty)
+}
+
+// ShowProblem displays a problem's statement with its difficulty and last verdict
+func (p *Presenter) ShowProblem(problem *domain.Problem, statement string, last *domain.Attempt) {
+	p.ShowResult(toStatementJSON(problem, statement, last))
+	if p.doc != nil {
+		return
+	}
+
+	difficulty := lipgloss.NewStyle().Foreground(difficultyColors[problem.Difficulty]).Render(problem.Difficulty)
+	verdict := faintStyle.Render("Not attempted yet")
+	if last != nil {
+		verdict = fmt.Sprintf("Last %s: %s, %s", last.Kind, last.Verdict, humanize.Time(last.CreatedAt))
+	}
+
+	p.print(headingStyle.Render(fmt.Sprintf("%s. %s", problem.ID, problem.Title)))
+	p.print(fmt.Sprintf("%s • %s • %s", difficulty, problem.Slug, verdict))
+	p.print("")
+	p.print(styleMarkdown(statement, statementWidth))
+	p.print(fmt.Sprintf("\nNext steps:\n  • Start solving:  kata solve %s\n  • Submit later:   kata submit %s", problem.Slug, problem.Slug))
+}
+
+// ShowSolutionReset displays that a solution was reset to the template
+func (p *Presenter) ShowSolutionReset(slug string) {
+	p.success("Reset solution, the previous code is kept in: kata history %s", slug)
+}
+
+// ShowSolutionKept displays that an existing solution is opened as it is
+func (p *Presenter) ShowSolutionKept(path string) {
+	p.info(fmt.Sprintf("Continuing your solution at %s, use --reset to start over", path))
+}
+
+// ShowTimerStarted displays the attempt timer started by kata solve
+func (p *Presenter) ShowTimerStarted(problem *domain.Problem, started time.Time) {
+	p.ShowResult(map[string]any{
+		"problem":   toProblemJSON(problem),
+		"solution":  problem.SolutionPath(),
+		"startedAt": started,
+	})
+	p.info("Timer started, it stops when a submission is accepted")
+}
+
+// ShowSolveTime displays how long an accepted attempt took since kata solve
+func (p *Presenter) ShowSolveTime(elapsed time.Duration) {
+	p.success("Solved in %s", elapsed.Round(time.Second))
+}
+
 // ShowLoginResult displays the login result with user stats
-func (p *Presenter) ShowLoginResult(username string, stats repository.GetStatsRow) error {
-	return p.renderLoginResult(username, stats)
+func (p *Presenter) ShowLoginResult(username string, stats repository.GetStatsRow, streak int) error {
+	p.ShowResult(toLoginJSON(username, stats, streak))
+	return p.renderLoginResult(username, stats, streak)
 }
 
 // ShowRenderResults displays the results of rendering/stubbing a problem
 func (p *Presenter) ShowRenderResults(result *render.RenderResult, slug string, force bool) {
+	view := toRenderJSON(result, slug)
+	if daily, ok := p.result().(*dailyJSON); ok {
+		// kata daily keeps the challenge and nests the stubbed files
+		daily.Stub = &view
+	} else {
+		p.ShowResult(view)
+	}
 	if result.DirectoryCreated != "" {
 		p.success("Created directory: %s", result.DirectoryCreated)
 	}
@@ -286,6 +670,13 @@ func (p *Presenter) ShowRenderResults(result *render.RenderResult, slug string,
 		p.warning("Note: Test file generation is not supported for this language")
 	}
 
+	if len(result.Warnings) > 0 {
+		p.warning("Examples could not be generated:")
+		for _, warning := range result.Warnings {
+			p.info(fmt.Sprintf("  • %s", warning))
+		}
+	}
+
 	if len(result.FilesSkipped) > 0 {
 		p.warning("Skipped files:")
 		for _, file := range result.FilesSkipped {
@@ -299,6 +690,14 @@ func (p *Presenter) ShowRenderResults(result *render.RenderResult, slug string,
 	p.nextSteps(slug)
 }
 
+func indent(text, prefix string) string {
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		lines[i] = prefix + line
+	}
+	return strings.Join(lines, "\n")
+}
+
 // Template rendering methods
 
 func (p *Presenter) renderQuizResult(problem *domain.Problem) error {
@@ -306,12 +705,13 @@ func (p *Presenter) renderQuizResult(problem *domain.Problem) error {
 	return t.Execute(p.writer, problem)
 }
 
-func (p *Presenter) renderLoginResult(username string, stats repository.GetStatsRow) error {
+func (p *Presenter) renderLoginResult(username string, stats repository.GetStatsRow, streak int) error {
 	t := template.Must(template.New("Login").Parse(loginTemplate))
 	return t.Execute(p.writer, map[string]string{
 		"Attempted": fmt.Sprint(stats.Attempted),
 		"Completed": fmt.Sprint(stats.Completed),
 		"Username":  username,
+		"Streak":    english.Plural(streak, "day", "days"),
 	})
 }
 
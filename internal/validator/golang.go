@@ -0,0 +1,36 @@
+package validator
+
+import (
+	"bytes"
+	"errors"
+	"go/format"
+	"go/parser"
+	"go/scanner"
+	"go/token"
+	"strings"
+)
+
+// goPackage makes a snippet a Go file, LeetCode adds its own package clause
+const goPackage = "package kata\n"
+
+// GoValidator parses Go snippets and formats them with gofmt
+type GoValidator struct{}
+
+func (GoValidator) Validate(code string) (string, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "solution.go", goPackage+code, parser.ParseComments)
+	if err != nil {
+		var list scanner.ErrorList
+		if errors.As(err, &list) && len(list) > 0 {
+			// Report the first error at its line in the snippet
+			return "", &SyntaxError{Line: max(list[0].Pos.Line-1, 1), Column: list[0].Pos.Column, Message: list[0].Msg}
+		}
+		return "", err
+	}
+
+	var formatted bytes.Buffer
+	if err := format.Node(&formatted, fset, file); err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(strings.TrimPrefix(formatted.String(), strings.TrimSpace(goPackage))), nil
+}
@@ -0,0 +1,52 @@
+package validator
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/phantompunk/kata/internal/domain"
+)
+
+var ErrInvalidSnippet = errors.New("solution has syntax errors")
+
+// Validator checks a snippet before it is submitted and returns it formatted
+// the way LeetCode should receive it
+type Validator interface {
+	Validate(code string) (string, error)
+}
+
+// SyntaxError is a problem found in a snippet, Line counts from one
+type SyntaxError struct {
+	Line    int
+	Column  int
+	Message string
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("%d:%d: %s", e.Line, e.Column, e.Message)
+}
+
+func (e *SyntaxError) Unwrap() error { return ErrInvalidSnippet }
+
+// validators are keyed by the LeetCode language they check, so languages
+// added in the config that submit as Go are checked as Go
+var validators = map[string]Validator{
+	"golang": GoValidator{},
+}
+
+// For returns the validator of a language, languages without one have their
+// tabs expanded as kata always submitted them
+func For(lang domain.Language) Validator {
+	if v, ok := validators[lang.LeetCodeSlug()]; ok {
+		return v
+	}
+	return Indent{}
+}
+
+// Indent replaces tabs with four spaces and does not check the code
+type Indent struct{}
+
+func (Indent) Validate(code string) (string, error) {
+	return strings.ReplaceAll(code, "\t", "    "), nil
+}
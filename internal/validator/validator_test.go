@@ -0,0 +1,35 @@
+package validator
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/phantompunk/kata/internal/domain"
+	"github.com/phantompunk/kata/pkg/assert"
+)
+
+func TestGoValidator(t *testing.T) {
+	t.Run("Formats valid code", func(t *testing.T) {
+		got, err := GoValidator{}.Validate("// sums\nfunc twoSum(nums []int,target int) []int {\n    return nil\n}")
+		assert.NilError(t, err)
+		assert.Equal(t, got, "// sums\nfunc twoSum(nums []int, target int) []int {\n\treturn nil\n}")
+	})
+
+	t.Run("Reports the line of a syntax error", func(t *testing.T) {
+		_, err := GoValidator{}.Validate("func twoSum() {\n\tx := \n}\n\nfunc f() {}")
+
+		var syntaxErr *SyntaxError
+		assert.True(t, errors.As(err, &syntaxErr))
+		assert.True(t, errors.Is(err, ErrInvalidSnippet))
+		assert.Equal(t, syntaxErr.Line, 3)
+	})
+}
+
+func TestFor(t *testing.T) {
+	_, isGo := For(domain.NewProgrammingLanguage("go")).(GoValidator)
+	assert.True(t, isGo)
+
+	got, err := For(domain.NewProgrammingLanguage("python")).Validate("class Solution:\n\tpass")
+	assert.NilError(t, err)
+	assert.Equal(t, got, "class Solution:\n    pass")
+}
@@ -7,10 +7,7 @@ import (
 )
 
 func Open(path string) error {
-	editor, args := findEditor()
-	cmdArgs := append(args, path)
-
-	cmd := exec.Command(editor, cmdArgs...)
+	cmd := Command(path)
 	cmd.Stdin = os.Stdin
 	cmd.Stdout = os.Stdout
 	cmd.Stderr = os.Stderr
@@ -18,6 +15,13 @@ func Open(path string) error {
 	return cmd.Run()
 }
 
+// Command builds the editor command for path without running it, so callers
+// like a TUI can hand the terminal over to the editor themselves
+func Command(path string) *exec.Cmd {
+	editor, args := findEditor()
+	return exec.Command(editor, append(args, path)...)
+}
+
 func findEditor() (string, []string) {
 	// 1. Check $VISUAL (GUI editors)
 	if editor := os.Getenv("VISUAL"); editor != "" {